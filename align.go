@@ -0,0 +1,55 @@
+package wordwrap
+
+// Alignment is how lines are placed within their limit.
+type Alignment int
+
+const (
+	// AlignLeft leaves lines as they are wrapped, against the left edge.
+	// This is the default.
+	AlignLeft Alignment = iota
+	// AlignCenter centers each line within its limit, padding both sides
+	// with spaces and the left with the extra space when the padding is odd.
+	AlignCenter
+	// AlignRight pushes each line against the right edge of its limit,
+	// padding its left with spaces, as for columns of numbers.
+	AlignRight
+)
+
+// Align sets how each line is placed within the limit of its line, after
+// wrapping and after trimming per TrimTrailingWhiteSpace. Padding is measured
+// in the active WidthMode, so lines of wide characters center on their
+// columns under MeasureDisplayWidth. Prefixes are placed with the lines they
+// start, and lines at or over their limit are unchanged.
+func Align(a Alignment) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.align = a
+		sb.asIs = a == AlignLeft
+	}
+}
+
+// alignLine pads line with spaces to place it within width per the Alignment.
+func (sb *SplitBuilder) alignLine(line string, width uint) string {
+	w := sb.measure(line)
+	if w >= width {
+		return line
+	}
+
+	slack := int(width - w)
+	left := 0
+	switch sb.align {
+	case AlignCenter:
+		left = (slack + 1) / 2
+	case AlignRight:
+		left = slack
+	default:
+		return line
+	}
+
+	b := make([]byte, len(line)+slack)
+	for i := range b {
+		b[i] = ' '
+	}
+	copy(b[left:], line)
+
+	return string(b)
+}
@@ -0,0 +1,62 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"hello world", []SplitBuilderOption{Align(AlignCenter)},
+			[]string{"     hello world    "}, 20},
+
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{Align(AlignCenter), TrimTrailingWhiteSpace(true)},
+			[]string{" the quick brown fox", " jumps over the lazy", "         dog        "}, 20},
+
+		{"centered", []SplitBuilderOption{Align(AlignCenter), LinePrefix("> ")},
+			[]string{"   > centered   "}, 16},
+
+		{"しかし 世界", []SplitBuilderOption{Align(AlignCenter), MeasureBy(MeasureDisplayWidth)},
+			[]string{"     しかし 世界    "}, 20},
+
+		{"abcdefghij", []SplitBuilderOption{Align(AlignCenter)},
+			[]string{"abcdefghij"}, 10},
+
+		{"12 345 6789", []SplitBuilderOption{Align(AlignRight)},
+			[]string{"      12 345 6789"}, 17},
+
+		{"the quick brown fox jumps", []SplitBuilderOption{Align(AlignRight), TrimTrailingWhiteSpace(true)},
+			[]string{" the quick", " brown fox", "     jumps"}, 10},
+
+		{"しかし 世界", []SplitBuilderOption{Align(AlignRight), MeasureBy(MeasureDisplayWidth)},
+			[]string{"         しかし 世界"}, 20},
+
+		{"しかし 世界", []SplitBuilderOption{Align(AlignRight), MeasureBy(MeasureRunes)},
+			[]string{"              しかし 世界"}, 20},
+
+		{"abcdefghij", []SplitBuilderOption{Align(AlignRight)},
+			[]string{"abcdefghij"}, 10},
+
+		{"a 世", []SplitBuilderOption{Align(AlignRight), OversizeHandler(func(string, uint) (string, Action) { return "", ActionEmit })},
+			[]string{"a ", "世"}, 2},
+
+		{"hello world", []SplitBuilderOption{Align(AlignLeft)},
+			[]string{"hello world"}, 20},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
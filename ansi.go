@@ -0,0 +1,34 @@
+package wordwrap
+
+// IgnoreANSI measures ANSI CSI escape sequences, such as the SGR sequence
+// "\x1b[31m" coloring terminal output, as zero wide, copying them into the
+// lines as is. A sequence is kept whole, so a break never falls within it.
+//
+// A sequence is an escape and "[" followed by parameter and intermediate
+// bytes up to a final byte from "@" to "~". An escape not starting a complete
+// sequence is measured as any other character.
+func IgnoreANSI(ignore bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.ignoreANSI = ignore
+		sb.asIs = true
+	}
+}
+
+// csiSize returns the size of the CSI escape sequence starting s, or 0 if s
+// does not start with a complete one.
+func csiSize(s string) int {
+	if len(s) < 3 || s[0] != '\x1b' || s[1] != '[' {
+		return 0
+	}
+
+	for i := 2; i < len(s); i++ {
+		switch b := s[i]; {
+		case b >= 0x40 && b <= 0x7e:
+			return i + 1
+		case b < 0x20 || b > 0x3f:
+			return 0
+		}
+	}
+
+	return 0
+}
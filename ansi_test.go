@@ -0,0 +1,41 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIgnoreANSI(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"\x1b[31mred\x1b[0m and plain",
+			[]string{"\x1b[31mred\x1b[0m and ", "plain"}, 9},
+
+		{"one \x1b[1;31mtwo three\x1b[0m four",
+			[]string{"one \x1b[1;31mtwo ", "three\x1b[0m ", "four"}, 8},
+
+		{"ab\x1b[31mcdef",
+			[]string{"ab\x1b[31mcd", "ef"}, 4},
+
+		{"\x1b[31mabc\x1b[0m",
+			[]string{"\x1b[31mabc\x1b[0m"}, 4},
+
+		{"ab\x1b[31",
+			[]string{"ab\x1b[", "31"}, 4},
+	}
+
+	sb := NewSplitBuilder(IgnoreANSI(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
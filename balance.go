@@ -0,0 +1,240 @@
+package wordwrap
+
+import (
+	"errors"
+	"unicode"
+)
+
+// ErrNoColumns is returned when balancing text across zero columns.
+var ErrNoColumns = errors.New("wordwrap: no columns to balance across")
+
+// BalanceMode is how Balance distributes text across columns.
+type BalanceMode int
+
+const (
+	// BalanceInOrder keeps paragraphs whole and in order, filling the
+	// columns left to right such that the tallest column is as short as
+	// possible. This is the default.
+	BalanceInOrder BalanceMode = iota
+	// BalanceDecreasing keeps paragraphs whole but not in order, placing
+	// the tallest paragraphs first, each in the shortest column so far.
+	// Paragraphs keep their input order within a column. This balances
+	// better than BalanceInOrder when paragraph heights vary.
+	BalanceDecreasing
+	// BalanceLines reflows the text across columns, breaking paragraphs
+	// between columns to give every column the same height give or take a
+	// line.
+	BalanceLines
+)
+
+// BalanceBy sets how Balance distributes text across columns.
+func BalanceBy(mode BalanceMode) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.balanceMode = mode
+		sb.asIs = true
+	}
+}
+
+// Balance wraps s to byteLimit and distributes the lines across the given
+// number of columns, newspaper style, minimizing the height of the tallest
+// column. Paragraphs are separated by blank lines in s and by an empty line
+// within a column.
+//
+// Paragraphs are not broken between columns unless BalanceBy(BalanceLines) is
+// set. Exactly columns columns are returned, some of which may be empty.
+func (sb *SplitBuilder) Balance(s string, byteLimit uint, columns uint) ([][]string, error) {
+	if columns == 0 {
+		return nil, ErrNoColumns
+	}
+
+	paragraphs := [][]string{}
+	for _, p := range splitParagraphs(s) {
+		lines, err := sb.SplitString(p, byteLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		paragraphs = append(paragraphs, lines)
+	}
+
+	var buckets [][]int
+	switch sb.balanceMode {
+	case BalanceDecreasing:
+		buckets = balanceDecreasing(paragraphs, int(columns))
+	case BalanceLines:
+		return balanceLines(paragraphs, int(columns)), nil
+	default:
+		buckets = balanceInOrder(paragraphs, int(columns))
+	}
+
+	out := make([][]string, columns)
+	for i := range out {
+		out[i] = []string{}
+		if i >= len(buckets) {
+			continue
+		}
+
+		for j, p := range buckets[i] {
+			if j > 0 {
+				out[i] = append(out[i], "")
+			}
+			out[i] = append(out[i], paragraphs[p]...)
+		}
+	}
+
+	return out, nil
+}
+
+// Balance wraps s to byteLimit and distributes the lines across the given
+// number of columns, keeping paragraphs whole and in order.
+func Balance(s string, byteLimit uint, columns uint) ([][]string, error) {
+	return DefaultSplitBuilder.Balance(s, byteLimit, columns)
+}
+
+// splitParagraphs splits s at blank lines, dropping the blank lines.
+func splitParagraphs(s string) []string {
+	paragraphs := []string{}
+
+	start, end := -1, -1
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && s[j] != '\n' {
+			j++
+		}
+
+		if !isBlank(s[i:j]) {
+			if start < 0 {
+				start = i
+			}
+			end = j
+		} else if start >= 0 {
+			paragraphs = append(paragraphs, s[start:end])
+			start = -1
+		}
+
+		i = j + 1
+	}
+
+	if start >= 0 {
+		paragraphs = append(paragraphs, s[start:end])
+	}
+
+	return paragraphs
+}
+
+func isBlank(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// balanceInOrder partitions the paragraphs in order into at most columns
+// buckets, minimizing the tallest bucket.
+func balanceInOrder(paragraphs [][]string, columns int) [][]int {
+	var lo, hi int
+	for _, p := range paragraphs {
+		if len(p) > lo {
+			lo = len(p)
+		}
+		hi += len(p) + 1
+	}
+
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if len(packInOrder(paragraphs, mid)) <= columns {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return packInOrder(paragraphs, lo)
+}
+
+// packInOrder fills buckets in order up to the given height.
+func packInOrder(paragraphs [][]string, height int) [][]int {
+	buckets := [][]int{}
+
+	cur := 0
+	for i, p := range paragraphs {
+		n := len(buckets)
+		if n > 0 && cur+1+len(p) <= height {
+			buckets[n-1] = append(buckets[n-1], i)
+			cur += 1 + len(p)
+			continue
+		}
+
+		buckets = append(buckets, []int{i})
+		cur = len(p)
+	}
+
+	return buckets
+}
+
+// balanceDecreasing places the tallest paragraphs first, each in the shortest
+// bucket so far.
+func balanceDecreasing(paragraphs [][]string, columns int) [][]int {
+	order := make([]int, len(paragraphs))
+	for i := range order {
+		order[i] = i
+		for j := i; j > 0 && len(paragraphs[order[j]]) > len(paragraphs[order[j-1]]); j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	buckets := make([][]int, columns)
+	heights := make([]int, columns)
+	for _, p := range order {
+		c := 0
+		for i, h := range heights {
+			if h < heights[c] {
+				c = i
+			}
+		}
+
+		if heights[c] > 0 {
+			heights[c]++
+		}
+		heights[c] += len(paragraphs[p])
+
+		// keep the input order within the bucket
+		b := append(buckets[c], p)
+		for j := len(b) - 1; j > 0 && b[j] < b[j-1]; j-- {
+			b[j], b[j-1] = b[j-1], b[j]
+		}
+		buckets[c] = b
+	}
+
+	return buckets
+}
+
+// balanceLines splits the lines of the paragraphs in order into columns of
+// equal height give or take a line.
+func balanceLines(paragraphs [][]string, columns int) [][]string {
+	lines := []string{}
+	for i, p := range paragraphs {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, p...)
+	}
+
+	out := make([][]string, columns)
+	start := 0
+	for i := range out {
+		// spread the remainder over the first columns
+		n := len(lines) / columns
+		if i < len(lines)%columns {
+			n++
+		}
+
+		out[i] = lines[start : start+n]
+		start += n
+	}
+
+	return out
+}
@@ -0,0 +1,51 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_Balance(t *testing.T) {
+	input := "aaa bbb ccc\n\nddd\n \t\neee fff\n\n\nggg hhh iii jjj\n"
+
+	tests := []struct {
+		input   string
+		mode    BalanceMode
+		columns uint
+		output  [][]string
+		err     error
+	}{
+		{input, BalanceInOrder, 2, [][]string{
+			{"aaa", "bbb", "ccc", "", "ddd"},
+			{"eee", "fff", "", "ggg", "hhh", "iii", "jjj"},
+		}, nil},
+
+		{input, BalanceDecreasing, 2, [][]string{
+			{"ddd", "", "ggg", "hhh", "iii", "jjj"},
+			{"aaa", "bbb", "ccc", "", "eee", "fff"},
+		}, nil},
+
+		{input, BalanceLines, 2, [][]string{
+			{"aaa", "bbb", "ccc", "", "ddd", "", "eee"},
+			{"fff", "", "ggg", "hhh", "iii", "jjj"},
+		}, nil},
+
+		{"aaa bbb", BalanceInOrder, 3, [][]string{
+			{"aaa", "bbb"}, {}, {},
+		}, nil},
+
+		{"", BalanceDecreasing, 2, [][]string{
+			{}, {},
+		}, nil},
+
+		{input, BalanceInOrder, 0, nil, ErrNoColumns},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(BalanceBy(test.mode), TrimTrailingWhiteSpace(true))
+		actual, err := sb.Balance(test.input, 4, test.columns)
+		if !reflect.DeepEqual(actual, test.output) || err != test.err {
+			t.Errorf(`Balance(%#v, %d) = %#v, %v; want %#v, %v`, test.input, test.columns, actual, err, test.output, test.err)
+		}
+	}
+}
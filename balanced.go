@@ -0,0 +1,211 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// Balanced breaks each paragraph into lines of as even a width as possible,
+// rather than filling each line greedily, which often leaves a very short last
+// line. A paragraph keeps the number of lines greedy breaking gives it, and
+// among the ways of breaking it into that many lines at its break
+// opportunities, the one with the least raggedness is chosen: the least sum of
+// the squares of the space left at the end of each line, the last included.
+//
+// The break opportunities of a paragraph and their widths are collected by a
+// greedy split before the breaks are chosen, which costs a second pass over
+// the text. Widths are measured on the input, so options rewriting the text,
+// such as ExpandTabs or DetectLinePrefix, may lead to a paragraph being broken
+// greedily in part. NextLine, which produces a line before the rest of the
+// text is seen, breaks greedily, while Writer, NewReader and ScanWrappedLines
+// hold the text back until it ends.
+func Balanced(balanced bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.balanced = balanced
+		sb.asIs = true
+	}
+}
+
+// balancedBreaks returns the byte offsets in the input at which to break the
+// lines of each paragraph to balance them.
+func (sp *splitter) balancedBreaks() map[int]bool {
+	pre := *sp.sb
+	pre.balanced, pre.nearLimit = false, nil
+	pre.maxLines, pre.minLines = 0, 0
+
+	lines := []line{}
+	greedy := pre.newSplitter(sp.s, sp.byteLimit, func(l line) bool {
+		lines = append(lines, l)
+		return true
+	})
+	greedy.collect, greedy.ctx = true, sp.ctx
+	if err := greedy.run(); err != nil {
+		return nil
+	}
+
+	breaks := map[int]bool{}
+	first := 0
+	for i, l := range lines {
+		if !l.hard && i < len(lines)-1 {
+			continue
+		}
+
+		for _, b := range sp.balanceParagraph(lines[first : i+1]) {
+			breaks[b] = true
+		}
+		first = i + 1
+	}
+
+	return breaks
+}
+
+// balanceParagraph returns the offsets at which to break the greedily broken
+// lines of a paragraph into as many lines of the least raggedness, or nothing
+// if they are to be kept as they are.
+func (sp *splitter) balanceParagraph(lines []line) []int {
+	n := len(lines)
+	if n < 2 {
+		return nil
+	}
+
+	// points holds the offsets of the start of the paragraph, its break
+	// opportunities in order and its end
+	start, end := lines[0].start, lines[n-1].end
+	points := []int{start}
+	for _, l := range lines {
+		for _, o := range l.opportunities {
+			if o > points[len(points)-1] && o < end {
+				points = append(points, o)
+			}
+		}
+
+		if l.end > points[len(points)-1] && l.end < end {
+			points = append(points, l.end)
+		}
+	}
+	points = append(points, end)
+
+	// width[p] is the width of the paragraph up to points[p], and trail[p]
+	// the start and width of the whitespace ending there, so the width of
+	// a line is found without measuring it
+	width := make([]uint, len(points))
+	trail := make([]struct {
+		start int
+		width uint
+	}, len(points))
+	trail[0].start = start
+	for p := 1; p < len(points); p++ {
+		piece := sp.s[points[p-1]:points[p]]
+		width[p] = width[p-1] + sp.sb.measure(piece)
+
+		if text := sp.sb.trimSpaceEnd(piece); text != "" || p == 1 {
+			trail[p].start = points[p-1] + len(text)
+			trail[p].width = sp.sb.measure(piece[len(text):])
+		} else {
+			trail[p].start = trail[p-1].start
+			trail[p].width = trail[p-1].width + width[p] - width[p-1]
+		}
+	}
+
+	// capacity[k] is the total limit of the first k lines
+	capacity := make([]uint, n+1)
+	for k := 0; k < n; k++ {
+		capacity[k+1] = capacity[k] + sp.balanceLimit(lines[0], k)
+	}
+
+	// cost[k][j-lo[k]] is the least raggedness of k+1 lines ending at
+	// points[j], or -1 if there is no way to break them, and from[k] the
+	// point the last of those lines starts at. Line k can only end at the
+	// points from lo[k] on which leave little enough for the lines after it,
+	// and up to those which the lines up to it can hold
+	last := len(points) - 1
+	cost := make([][]int, n)
+	from := make([][]int, n)
+	lo := make([]int, n)
+	for k := range cost {
+		limit := capacity[k+1] - capacity[k]
+
+		for lo[k] < last && width[last]-width[lo[k]] > capacity[n]-capacity[k+1] {
+			lo[k]++
+		}
+		hi := lo[k]
+		for hi < last && width[hi+1] <= capacity[k+1] {
+			hi++
+		}
+
+		cost[k], from[k] = make([]int, hi-lo[k]+1), make([]int, hi-lo[k]+1)
+		for j := lo[k]; j <= hi; j++ {
+			best := -1
+			for i := j - 1; i >= 0; i-- {
+				w := width[j] - width[i]
+				if w > limit {
+					break
+				}
+
+				prev := 0
+				if k > 0 {
+					if i < lo[k-1] {
+						break
+					}
+					if i-lo[k-1] >= len(cost[k-1]) {
+						continue
+					}
+					prev = cost[k-1][i-lo[k-1]]
+				} else if i > 0 {
+					continue
+				}
+				if prev < 0 {
+					continue
+				}
+
+				if trail[j].start > points[i] {
+					w -= trail[j].width
+				} else {
+					w = 0
+				}
+
+				slack := int(limit - w)
+				if c := prev + slack*slack; best < 0 || c < best {
+					best, from[k][j-lo[k]] = c, i
+				}
+			}
+			cost[k][j-lo[k]] = best
+		}
+	}
+
+	if lo[n-1] != last || cost[n-1][0] < 0 {
+		return nil
+	}
+
+	breaks := make([]int, n-1)
+	for k, j := n-1, last; k > 0; k-- {
+		j = from[k][j-lo[k]]
+		breaks[k-1] = points[j]
+	}
+
+	return breaks
+}
+
+// balanceLimit returns the limit of the content of the line k lines after
+// first.
+func (sp *splitter) balanceLimit(first line, k int) uint {
+	index := first.index + k
+	limit := sp.sb.limitFor(index, sp.byteLimit)
+	prefix := sp.sb.measure(sp.sb.prefixFor(index) + first.prefix)
+	if prefix > limit {
+		return 0
+	}
+
+	return limit - prefix
+}
+
+// trimSpaceEnd returns s less its trailing whitespace.
+func (sb *SplitBuilder) trimSpaceEnd(s string) string {
+	end := len(s)
+	for end > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:end])
+		if !sb.isSpace(r) {
+			break
+		}
+		end -= size
+	}
+
+	return s[:end]
+}
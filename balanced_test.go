@@ -0,0 +1,89 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBalanced(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", nil,
+			[]string{"the quick brown ", "fox jumps over ", "the lazy dog"}, 20},
+
+		{"aaa bbb ccc ddd eee", nil,
+			[]string{"aaa bbb ccc ", "ddd eee"}, 16},
+
+		{"one two three four\nfive six seven eight nine ten", []SplitBuilderOption{PreserveNewlines(true)},
+			[]string{"one two ", "three four", "five six seven ", "eight nine ten"}, 16},
+
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{LinePrefix("> ")},
+			[]string{"> the quick brown ", "> fox jumps over ", "> the lazy dog"}, 22},
+
+		{"short", nil,
+			[]string{"short"}, 16},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append(test.options, Balanced(true))...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		greedy, _ := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if len(actual) != len(greedy) {
+			t.Errorf(`SplitString(%#v) gave %d lines; want the %d of greedy breaking`, test.input, len(actual), len(greedy))
+		}
+	}
+}
+
+func TestBalanced_evensLastLine(t *testing.T) {
+	input := "the quick brown fox jumps over the lazy dog"
+
+	greedy := SplitString(input, 20)
+	if last := greedy[len(greedy)-1]; last != "dog" {
+		t.Fatalf(`greedy last line = %#v; want the one word "dog"`, last)
+	}
+
+	balanced, err := NewSplitBuilder(Balanced(true)).SplitString(input, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(balanced) != len(greedy) {
+		t.Fatalf(`balanced gave %d lines; want %d`, len(balanced), len(greedy))
+	}
+
+	shortest, longest := len(balanced[0]), len(balanced[0])
+	for _, l := range balanced {
+		if len(l) < shortest {
+			shortest = len(l)
+		}
+		if len(l) > longest {
+			longest = len(l)
+		}
+	}
+
+	if longest-shortest > 4 {
+		t.Errorf(`balanced lines %#v differ in length by %d; want at most 4`, balanced, longest-shortest)
+	}
+}
+
+func BenchmarkBalanced(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 640)
+	sb := NewSplitBuilder(Balanced(true))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sb.SplitString(s, 80)
+	}
+}
@@ -0,0 +1,58 @@
+package wordwrap
+
+import "unicode"
+
+// Direction is the base direction of text.
+type Direction int
+
+const (
+	// DirectionLTR is left-to-right text. This is the default.
+	DirectionLTR Direction = iota
+	// DirectionRTL is right-to-left text, such as Hebrew or Arabic.
+	DirectionRTL
+	// DirectionAuto detects the direction of each line from its first
+	// strongly directional letter, defaulting to left-to-right.
+	DirectionAuto
+)
+
+// BaseDirection sets the base direction of the text, used by TrimVisualEnd.
+func BaseDirection(d Direction) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.baseDirection = d
+		sb.asIs = true
+	}
+}
+
+// TrimVisualEnd trims the whitespace displayed at the right-hand end of
+// right-to-left lines, which is their logical start, rather than their
+// logical end as TrimTrailingWhiteSpace does. Whether a line is right-to-left
+// is given by BaseDirection, so this has no effect unless it is set.
+// Left-to-right lines are unaffected and still trimmed by
+// TrimTrailingWhiteSpace if set.
+func TrimVisualEnd(trim bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.trimVisualEnd = trim
+		sb.asIs = !trim
+	}
+}
+
+var rtlScripts = []*unicode.RangeTable{
+	unicode.Hebrew, unicode.Arabic, unicode.Syriac, unicode.Thaana,
+	unicode.Nko, unicode.Samaritan, unicode.Mandaic,
+}
+
+// isRTL reports whether line is right-to-left per the base direction.
+func (sb *SplitBuilder) isRTL(line string) bool {
+	switch sb.baseDirection {
+	case DirectionRTL:
+		return true
+	case DirectionAuto:
+		for _, r := range line {
+			if unicode.IsLetter(r) {
+				return unicode.In(r, rtlScripts...)
+			}
+		}
+	}
+
+	return false
+}
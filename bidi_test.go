@@ -0,0 +1,42 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrimVisualEnd(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"  שלום עולם", []SplitBuilderOption{BaseDirection(DirectionRTL)},
+			[]string{"שלום ", "עולם"}, 12},
+
+		{"  مرحبا ", []SplitBuilderOption{BaseDirection(DirectionAuto)},
+			[]string{"مرحبا "}, 20},
+
+		{"  hello world ", []SplitBuilderOption{BaseDirection(DirectionAuto)},
+			[]string{"  hello world "}, 20},
+
+		{"  hello world ", []SplitBuilderOption{BaseDirection(DirectionAuto), TrimTrailingWhiteSpace(true)},
+			[]string{"  hello world"}, 20},
+
+		{"  שלום ", nil,
+			[]string{"  שלום "}, 20},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{TrimVisualEnd(true)}, test.options...)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package wordwrap
+
+// BoxBorders pads every line with trailing spaces to the limit of its line and
+// surrounds it with the left and right borders, for drawing text boxes such as
+// "│ content     │". The borders are outside of the limit. Top and bottom
+// borders can be drawn with BoxRule.
+//
+// Borders only line up on a terminal when lines are measured in cells. The
+// box-drawing characters from U+2500 to U+257F occupy a single cell but three
+// bytes, and under MeasureBytes multibyte content is padded by its bytes rather
+// than its cells. CategoryWeights(map[string]int{"Mn": 0, "Me": 0, "Wide": 2})
+// measures in cells for most text.
+func BoxBorders(left, right string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.boxed = true
+		sb.boxLeft, sb.boxRight = left, right
+	}
+}
+
+// boxLine pads line to width and surrounds it with the box borders.
+func (sb *SplitBuilder) boxLine(line string, width uint) string {
+	return sb.boxLeft + sb.padRight(line, width) + sb.boxRight
+}
+
+// BoxRule returns a top or bottom border for lines boxed by BoxBorders at
+// byteLimit: left, then fill repeated as many times as fits within byteLimit,
+// padded with spaces to byteLimit, then right. Widths are measured as the
+// SplitBuilder measures lines, such that
+//
+//	sb.BoxRule("┌─", "─", "─┐", 20)
+//
+// spans the same number of cells as the lines of BoxBorders("│ ", " │") when
+// measuring in cells.
+func (sb *SplitBuilder) BoxRule(left, fill, right string, byteLimit uint) string {
+	b := make([]byte, 0, int(byteLimit))
+
+	fw := sb.measure(fill)
+	var w uint
+	for fw > 0 && w+fw <= byteLimit {
+		b = append(b, fill...)
+		w += fw
+	}
+
+	return left + sb.padRight(string(b), byteLimit) + right
+}
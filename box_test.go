@@ -0,0 +1,56 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoxBorders(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox", nil,
+			[]string{"| the quick  |", "| brown fox  |"}, 10},
+
+		{"the quick brown fox", []SplitBuilderOption{FirstLineLimit(4)},
+			[]string{"| the  |", "| quick      |", "| brown fox  |"}, 10},
+
+		{"", nil,
+			[]string{}, 10},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{BoxBorders("| ", " |")}, test.options...)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitBuilder_BoxRule(t *testing.T) {
+	tests := []struct {
+		sb                *SplitBuilder
+		left, fill, right string
+		output            string
+		bytelim           uint
+	}{
+		{NewSplitBuilder(), "+-", "-", "-+", "+------------+", 10},
+		{NewSplitBuilder(), "+-", "=-", "-+", "+-=-=-=-=-=--+", 10},
+		{NewSplitBuilder(), "+-", "=-", "-+", "+-=-=-=-=- -+", 9},
+		{NewSplitBuilder(CategoryWeights(map[string]int{})), "┌─", "─", "─┐", "┌────────┐", 6},
+	}
+
+	for _, test := range tests {
+		if actual := test.sb.BoxRule(test.left, test.fill, test.right, test.bytelim); actual != test.output {
+			t.Errorf(`BoxRule(%#v, %#v, %#v, %d) = %#v; want %#v`, test.left, test.fill, test.right, test.bytelim, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// BreakAfter sets a function deciding whether a line may break between the
+// runes prev and next, in place of breaking after whitespace. For instance a
+// function allowing breaks after a "/" as well as after whitespace wraps file
+// paths and URLs. next is utf8.RuneError at the end of the input.
+//
+// The opportunities added by options such as BreakOnHyphens, UseUAX14 or
+// BreakPriorities are still offered, and HonorNonBreakingSpace still removes
+// those at non-breaking spaces. Whitespace, as set by WhitespaceFunc, still
+// decides where words begin and end and what is trimmed. A nil fn restores
+// the default.
+func BreakAfter(fn func(prev, next rune) bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.breakFunc = fn
+		sb.asIs = true
+	}
+}
+
+// breaksBetween reports whether a line may break after the rune r, followed
+// at byte offset next of s, by whitespace or per BreakAfter.
+func (sb *SplitBuilder) breaksBetween(s string, next int, r rune) bool {
+	if sb.breakFunc == nil {
+		return sb.isSpace(r)
+	}
+
+	following, _ := utf8.DecodeRuneInString(s[next:])
+	return sb.breakFunc(r, following)
+}
@@ -0,0 +1,72 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestBreakAfter(t *testing.T) {
+	paths := func(prev, next rune) bool {
+		return prev == '/' || unicode.IsSpace(prev)
+	}
+	onlySlash := func(prev, next rune) bool {
+		return prev == '/' && next != '/'
+	}
+
+	tests := []struct {
+		input   string
+		fn      func(prev, next rune) bool
+		output  []string
+		bytelim uint
+	}{
+		{"/usr/local/share/doc/wordwrap/README", paths,
+			[]string{"/usr/local/", "share/doc/", "wordwrap/", "README"}, 12},
+
+		{"see /usr/local/share for docs", paths,
+			[]string{"see /usr/", "local/", "share for ", "docs"}, 11},
+
+		{"a b c d e f", onlySlash,
+			[]string{"a b c ", "d e f"}, 6},
+
+		{"http://example.com/a/b", onlySlash,
+			[]string{"http://", "example.com/", "a/b"}, 13},
+
+		{"/usr/local/share", nil,
+			[]string{"/usr/local/s", "hare"}, 12},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(BreakAfter(test.fn)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestBreakAfter_invalidUTF8(t *testing.T) {
+	var seen []rune
+	fn := func(prev, next rune) bool {
+		seen = append(seen, prev, next)
+		return prev == '/'
+	}
+
+	actual, err := NewSplitBuilder(BreakAfter(fn), InvalidUTF8Policy(InvalidUTF8Keep)).SplitString("a/\xff/bcdef", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"a/\xff/", "bcdef"}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf(`SplitString = %#v; want %#v`, actual, want)
+	}
+
+	if !reflect.DeepEqual(seen[:6], []rune{'a', '/', '/', utf8.RuneError, utf8.RuneError, '/'}) {
+		t.Errorf(`BreakAfter saw %q; want the invalid byte as utf8.RuneError`, seen[:6])
+	}
+}
@@ -0,0 +1,55 @@
+package wordwrap
+
+// SplitBytes splits b as SplitString splits a string, for callers holding
+// bytes. b is converted to a string once, and each line which holds its
+// content from b as is aliases b rather than being copied, so the lines must
+// not be modified unless b may be, and remain valid only as long as b is
+// unchanged. Lines whose content differs from the input, such as padded,
+// trimmed or prefixed lines, are copies.
+//
+// If a character is larger than the limit of the line it falls on the lines
+// produced up to that point are returned along with ErrCharacterTooLarge.
+func (sb *SplitBuilder) SplitBytes(b []byte, byteLimit uint) ([][]byte, error) {
+	s := string(b)
+	if sb.fitsAsIs(s, byteLimit) {
+		return [][]byte{b[:len(b):len(b)]}, nil
+	}
+
+	lines := [][]byte{}
+	err := sb.split(s, byteLimit, func(l line) bool {
+		if l.text == s[l.start:l.end] {
+			lines = append(lines, b[l.start:l.end:l.end])
+		} else {
+			lines = append(lines, []byte(l.text))
+		}
+		return true
+	})
+
+	return lines, err
+}
+
+// WrapBytes splits b as SplitBytes does and joins the lines as Wrap would,
+// into a new slice which never aliases b.
+func (sb *SplitBuilder) WrapBytes(b []byte, byteLimit uint) ([]byte, error) {
+	out := make([]byte, 0, len(b)+len(b)/int(byteLimit+1))
+	sep := ""
+	err := sb.split(string(b), byteLimit, func(l line) bool {
+		out = append(out, sep...)
+		out = append(out, l.text...)
+		sep = sb.separator(l)
+		return true
+	})
+
+	return out, err
+}
+
+// SplitBytes splits b as SplitString splits a string, the lines aliasing b.
+func SplitBytes(b []byte, byteLimit uint) ([][]byte, error) {
+	return DefaultSplitBuilder.SplitBytes(b, byteLimit)
+}
+
+// WrapBytes splits b as SplitString splits a string and joins the lines with
+// a \n as WrapString does.
+func WrapBytes(b []byte, byteLimit uint) ([]byte, error) {
+	return DefaultSplitBuilder.WrapBytes(b, byteLimit)
+}
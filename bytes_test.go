@@ -0,0 +1,120 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitBytes(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", nil, 4},
+		{"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕", nil, 9},
+		{"the quick brown fox", []SplitBuilderOption{TrimTrailingWhiteSpace(true), PadLastLine(true)}, 10},
+		{"the quick brown fox", []SplitBuilderOption{LinePrefix("> "), SoftBreakSeparator(" \\\n")}, 10},
+		{"short", nil, 10},
+		{"", nil, 10},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(test.options...)
+		want, _ := sb.SplitString(test.input, test.bytelim)
+		wrapped, _ := sb.Wrap(test.input, test.bytelim)
+
+		lines, err := sb.SplitBytes([]byte(test.input), test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitBytes(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		actual := []string{}
+		for _, l := range lines {
+			actual = append(actual, string(l))
+		}
+
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf(`SplitBytes(%#v) = %#v; want %#v`, test.input, actual, want)
+		}
+
+		b, err := sb.WrapBytes([]byte(test.input), test.bytelim)
+		if err != nil {
+			t.Fatalf(`WrapBytes(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if string(b) != wrapped {
+			t.Errorf(`WrapBytes(%#v) = %#v; want %#v`, test.input, string(b), wrapped)
+		}
+	}
+}
+
+func TestSplitBytes_aliases(t *testing.T) {
+	tests := []struct {
+		input   string
+		bytelim uint
+		line    int
+	}{
+		{"aaa bbb ccc", 4, 1},
+		{"aaa bbb ccc", 20, 0},
+	}
+
+	for _, test := range tests {
+		// spare capacity past the input, which appending to a line must not
+		// write into
+		buf := make([]byte, len(test.input), len(test.input)+8)
+		copy(buf, test.input)
+
+		lines, err := SplitBytes(buf, test.bytelim)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		buf[4] = 'B'
+		if !strings.Contains(string(lines[test.line]), "Bbb") {
+			t.Errorf(`SplitBytes(%#v, %d) line %#v; want it to alias the input`, test.input, test.bytelim, string(lines[test.line]))
+		}
+
+		for i := range lines {
+			lines[i] = append(lines[i], 'x')
+		}
+		if tail := buf[:cap(buf)][len(buf)]; tail == 'x' {
+			t.Errorf(`appending to a SplitBytes(%#v, %d) line wrote past the input`, test.input, test.bytelim)
+		}
+		if got := string(buf); got != "aaa Bbb ccc" {
+			t.Errorf(`appending to a SplitBytes(%#v, %d) line changed the input to %#v`, test.input, test.bytelim, got)
+		}
+	}
+}
+
+func TestSplitBytes_characterTooLarge(t *testing.T) {
+	lines, err := SplitBytes([]byte("ab し"), 2)
+	if err != ErrCharacterTooLarge {
+		t.Fatalf(`SplitBytes error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if len(lines) != 2 || string(lines[0]) != "ab" {
+		t.Errorf(`SplitBytes lines = %q; want the lines before the error`, lines)
+	}
+}
+
+func BenchmarkSplitBytes_manyLines(b *testing.B) {
+	s := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SplitBytes(s, 40)
+	}
+}
+
+func BenchmarkSplitBytes_viaString(b *testing.B) {
+	s := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		lines := SplitString(string(s), 40)
+		out := make([][]byte, len(lines))
+		for j, l := range lines {
+			out[j] = []byte(l)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// CategoryWeights measures lines by summing a weight for each rune, chosen by
+// its Unicode general category. It takes precedence over MeasureBy.
+//
+// Keys are the category names of unicode.Categories, either a two letter
+// category such as "Mn" or "Lu", or a one letter major category such as "M"
+// or "L". The key "Wide" matches East Asian wide and fullwidth runes, as
+// measured by terminals. The most specific key wins: "Wide" over a two letter
+// category over a major category. Runes matching no key weigh 1, and unknown
+// keys are ignored.
+//
+// For example, counting combining marks as 0 and wide runes as 2:
+//
+//	CategoryWeights(map[string]int{"Mn": 0, "Me": 0, "Wide": 2})
+//
+// A character weighing less than 0 in total is measured as 0.
+func CategoryWeights(weights map[string]int) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.categoryWeights = nil
+		sb.categoryRules = nil
+		if weights == nil {
+			return
+		}
+
+		sb.categoryWeights = make(map[string]int, len(weights))
+		for k, v := range weights {
+			sb.categoryWeights[k] = v
+		}
+
+		if w, ok := weights["Wide"]; ok {
+			sb.categoryRules = append(sb.categoryRules, categoryRule{nil, w})
+		}
+
+		// two letter categories are more specific than major categories
+		for _, n := range []int{2, 1} {
+			for k, w := range weights {
+				if t, ok := unicode.Categories[k]; ok && len(k) == n {
+					sb.categoryRules = append(sb.categoryRules, categoryRule{t, w})
+				}
+			}
+		}
+		sb.asIs = true
+	}
+}
+
+// categoryRule weighs runes in table, or East Asian wide runes if table is
+// nil.
+type categoryRule struct {
+	table  *unicode.RangeTable
+	weight int
+}
+
+// categoryWidth returns the summed category weight of the runes of c.
+func (sb *SplitBuilder) categoryWidth(c string) uint {
+	w := 0
+	for i := 0; i < len(c); {
+		r, size := utf8.DecodeRuneInString(c[i:])
+		w += sb.runeWeight(r)
+		i += size
+	}
+
+	if w < 0 {
+		return 0
+	}
+
+	return uint(w)
+}
+
+func (sb *SplitBuilder) runeWeight(r rune) int {
+	for _, rule := range sb.categoryRules {
+		if rule.table == nil && inTable(r, wideTable) || rule.table != nil && unicode.Is(rule.table, r) {
+			return rule.weight
+		}
+	}
+
+	return 1
+}
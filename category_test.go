@@ -0,0 +1,42 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCategoryWeights(t *testing.T) {
+	tests := []struct {
+		input   string
+		weights map[string]int
+		output  []string
+		bytelim uint
+	}{
+		{"ééé abc", map[string]int{"Mn": 0},
+			[]string{"ééé ", "abc"}, 4},
+
+		{"世界 abc", map[string]int{"Wide": 2},
+			[]string{"世界", " ", "abc"}, 4},
+
+		{"世界 abc", map[string]int{"Wide": 2, "L": 3},
+			[]string{"世界 ", "ab", "c"}, 6},
+
+		{"ab, cd", map[string]int{"P": 0, "Zs": 0},
+			[]string{"ab", ", ", "cd"}, 2},
+
+		{"abc def", map[string]int{"Nope": 5},
+			[]string{"abc ", "def"}, 4},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(CategoryWeights(test.weights))
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// CoalesceBreakRuns makes a run of identical whitespace runes offer a single
+// break opportunity, after its last rune, rather than one after each. With a
+// WhitespaceFunc treating hyphens or dots as whitespace, this keeps a "---" or
+// "..." from being broken partway through.
+//
+// Only the break opportunities are removed. If no other break fits, a run is
+// still broken as any word would be.
+func CoalesceBreakRuns(coalesce bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.coalesceBreakRuns = coalesce
+		sb.asIs = true
+	}
+}
+
+// continuesRun reports whether the rune r of the given size found at byte
+// offset i of s is followed by the same rune.
+func continuesRun(s string, i, size int, r rune) bool {
+	if i+size >= len(s) {
+		return false
+	}
+
+	next, _ := utf8.DecodeRuneInString(s[i+size:])
+	return next == r
+}
@@ -0,0 +1,52 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestCoalesceBreakRuns(t *testing.T) {
+	breakOnPunct := WhitespaceFunc(func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == '.'
+	})
+
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"x ab---cd", []SplitBuilderOption{breakOnPunct},
+			[]string{"x ab--", "-cd"}, 6},
+
+		{"x ab---cd", []SplitBuilderOption{breakOnPunct, CoalesceBreakRuns(true)},
+			[]string{"x ", "ab---", "cd"}, 6},
+
+		{"so... then", []SplitBuilderOption{breakOnPunct, CoalesceBreakRuns(true)},
+			[]string{"so..", ". ", "then"}, 4},
+
+		{"so... then", []SplitBuilderOption{breakOnPunct, CoalesceBreakRuns(true)},
+			[]string{"so... ", "then"}, 7},
+
+		{"wait...what", []SplitBuilderOption{breakOnPunct, CoalesceBreakRuns(true)},
+			[]string{"wait.", "..", "what"}, 5},
+
+		{"a-.b", []SplitBuilderOption{breakOnPunct, CoalesceBreakRuns(true)},
+			[]string{"a-.", "b"}, 3},
+
+		{"a  bc d", []SplitBuilderOption{CoalesceBreakRuns(true)},
+			[]string{"a  ", "bc d"}, 5},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package wordwrap
+
+// CollapseWhitespace collapses each run of whitespace into a single space, as
+// in text copied from PDFs or HTML, freeing the room the runs took for the
+// words around them. Unlike TrimTrailingWhiteSpace, it applies within lines
+// as well as at their ends. Tabs are collapsed rather than expanded per
+// ExpandTabs, while non-breaking spaces honored per HonorNonBreakingSpace are
+// kept as is. Breaks kept by PreserveNewlines, MarkdownHardBreaks or
+// OnlyReflowOverLong end a run.
+func CollapseWhitespace(collapse bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.collapseWhitespace = collapse
+		sb.asIs = !collapse
+	}
+}
+
+// collapsible reports whether r is whitespace collapsed per
+// CollapseWhitespace.
+func (sb *SplitBuilder) collapsible(r rune) bool {
+	return sb.isSpace(r) && !(sb.honorNBSP && isNonBreaking(r))
+}
+
+// collapse replaces the character c, starting with the rune r, with a single
+// space if it starts a run of whitespace, or drops it if it continues one.
+func (sp *splitter) collapse(c *charPos, r rune) {
+	space := sp.sb.collapsible(r)
+	switch {
+	case space && sp.afterSpace:
+		c.text, c.replaced, c.width = "", true, 0
+	case space && r != ' ':
+		c.text, c.replaced, c.width = " ", true, sp.sb.charWidth(" ")
+	}
+
+	sp.afterSpace = space
+}
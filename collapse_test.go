@@ -0,0 +1,59 @@
+package wordwrap
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCollapseWhitespace(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"a   b    c", nil,
+			[]string{"a b c"}, 80},
+
+		{"the   quick\t\tbrown \n fox", nil,
+			[]string{"the quick ", "brown fox"}, 10},
+
+		{"  leading and trailing  ", nil,
+			[]string{" leading ", "and ", "trailing "}, 10},
+
+		{"a  \n  b", []SplitBuilderOption{PreserveNewlines(true)},
+			[]string{"a ", " b"}, 10},
+
+		{"a\t\tb", []SplitBuilderOption{ExpandTabs(4)},
+			[]string{"a b"}, 10},
+
+		{"10\u00a0\u00a0kg  now", []SplitBuilderOption{HonorNonBreakingSpace(true)},
+			[]string{"10\u00a0\u00a0kg now"}, 20},
+	}
+
+	for _, test := range tests {
+		options := append([]SplitBuilderOption{CollapseWhitespace(true)}, test.options...)
+		actual, err := NewSplitBuilder(options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(test.input)))
+		scanner.Split(ScanWrappedLines(test.bytelim, options...))
+		scanned := []string{}
+		for scanner.Scan() {
+			scanned = append(scanned, scanner.Text())
+		}
+
+		if !reflect.DeepEqual(scanned, test.output) {
+			t.Errorf(`Scan(%#v) = %#v; want %#v`, test.input, scanned, test.output)
+		}
+	}
+}
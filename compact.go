@@ -0,0 +1,43 @@
+package wordwrap
+
+// CompactShortLines merges a line ending at a newline of the input with the
+// following line when both are narrower than threshold and together, joined
+// by a space, they fit within the limit. Poorly broken input, such as short
+// lines kept by OnlyReflowOverLong, is compacted this way before re-wrapping.
+//
+// Lines are never merged across a Markdown hard break, a line broken by
+// wrapping, or an empty line, such that paragraphs stay apart. A threshold of
+// 0 disables compaction.
+func CompactShortLines(threshold uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.compactThreshold = threshold
+		sb.asIs = true
+	}
+}
+
+// compacts reports whether next may be merged onto the end of prev.
+func (sp *splitter) compacts(prev, next line) bool {
+	threshold := sp.sb.compactThreshold
+	if threshold == 0 || !prev.newline || prev.text == "" || next.text == "" {
+		return false
+	}
+
+	pw, nw := sp.sb.measure(prev.text), sp.sb.measure(next.text)
+	if pw >= threshold || nw >= threshold {
+		return false
+	}
+
+	return pw+sp.sb.measure(" ")+nw <= sp.sb.limitFor(prev.index, sp.byteLimit)
+}
+
+// compact merges next onto the end of prev.
+func (sp *splitter) compact(prev, next line) line {
+	prev.text += " " + next.text
+	prev.end = next.end
+	prev.mdOpen = next.mdOpen
+	prev.newline = next.newline
+	prev.hard = next.hard
+	prev.opportunities = append(prev.opportunities, next.opportunities...)
+
+	return prev
+}
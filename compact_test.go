@@ -0,0 +1,47 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompactShortLines(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"one\ntwo\nthree\n\nfour\nfive", []SplitBuilderOption{OnlyReflowOverLong(true), CompactShortLines(6)},
+			[]string{"one two", "three", "", "four five"}, 20},
+
+		{"one\ntwo\nthree", []SplitBuilderOption{OnlyReflowOverLong(true), CompactShortLines(6)},
+			[]string{"one two", "three"}, 8},
+
+		{"one\ntwo\nthree", []SplitBuilderOption{OnlyReflowOverLong(true), CompactShortLines(10)},
+			[]string{"one two three"}, 20},
+
+		{"one\ntwo\nthree", []SplitBuilderOption{OnlyReflowOverLong(true), CompactShortLines(4)},
+			[]string{"one two", "three"}, 20},
+
+		{"one\ntwo\nthree", []SplitBuilderOption{OnlyReflowOverLong(true)},
+			[]string{"one", "two", "three"}, 20},
+
+		{"one  \ntwo", []SplitBuilderOption{MarkdownHardBreaks(true), CompactShortLines(6)},
+			[]string{"one  ", "two"}, 20},
+
+		{"one\ntwo\nthree", []SplitBuilderOption{OnlyReflowOverLong(true), CompactShortLines(6), MaxLines(1)},
+			[]string{"one two"}, 20},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package wordwrap
+
+import "context"
+
+// ctxCheckInterval is the number of characters read between checks of the
+// context of SplitStringContext.
+const ctxCheckInterval = 1024
+
+// SplitStringContext splits s as SplitString does, checking ctx as it goes so
+// that splitting a huge input can be abandoned, as when the client of a
+// request goes away. Once ctx is done, splitting stops within a few thousand
+// characters and the lines produced up to then are returned along with
+// ctx.Err(), or the error alone per ErrorReturnsPartial.
+func (sb *SplitBuilder) SplitStringContext(ctx context.Context, s string, byteLimit uint) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return []string{}, err
+	}
+
+	lines := []string{}
+	sp := sb.newSplitter(s, byteLimit, func(l line) bool {
+		lines = append(lines, l.text)
+		return true
+	})
+	sp.ctx = ctx
+
+	err := sp.run()
+
+	return lines, err
+}
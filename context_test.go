@@ -0,0 +1,51 @@
+package wordwrap
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitStringContext(t *testing.T) {
+	const input = "the quick brown fox jumps over the lazy dog"
+
+	lines, err := NewSplitBuilder().SplitStringContext(context.Background(), input, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := SplitString(input, 10); !reflect.DeepEqual(lines, want) {
+		t.Errorf(`SplitStringContext = %#v; want %#v`, lines, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewSplitBuilder().SplitStringContext(ctx, input, 10); err != context.Canceled {
+		t.Errorf(`SplitStringContext error = %v; want %v`, err, context.Canceled)
+	}
+}
+
+func TestSplitStringContext_cancelledMidway(t *testing.T) {
+	input := strings.Repeat("the quick brown fox jumps over the lazy dog ", 10000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	produced := 0
+	sb := NewSplitBuilder(NearLimitCallback(40, func(lineIndex int, width uint) {
+		if produced++; produced == 100 {
+			cancel()
+		}
+	}))
+
+	lines, err := sb.SplitStringContext(ctx, input, 40)
+	if err != context.Canceled {
+		t.Fatalf(`SplitStringContext error = %v; want %v`, err, context.Canceled)
+	}
+
+	if len(lines) < 100 || len(lines) > 100+ctxCheckInterval/10 {
+		t.Errorf(`SplitStringContext produced %d lines; want to stop shortly after 100`, len(lines))
+	}
+}
@@ -0,0 +1,29 @@
+package wordwrap
+
+// CountLines returns the number of lines SplitString would split s into,
+// without building them, as for working out the height of a layout. Every
+// option is honored, MaxLines included.
+//
+// The text is split all the same, so CountLines takes about as long as
+// SplitString; it only saves allocating the lines and the slice of them.
+//
+// If a character is larger than the limit of the line it falls on the number
+// of lines SplitString returns along with the error is returned with it.
+func (sb *SplitBuilder) CountLines(s string, byteLimit uint) (int, error) {
+	if sb.fitsAsIs(s, byteLimit) {
+		return 1, nil
+	}
+
+	n := 0
+	err := sb.split(s, byteLimit, func(l line) bool {
+		n++
+		return true
+	})
+
+	return n, err
+}
+
+// CountLines returns the number of lines SplitString would split s into.
+func CountLines(s string, byteLimit uint) (int, error) {
+	return DefaultSplitBuilder.CountLines(s, byteLimit)
+}
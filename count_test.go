@@ -0,0 +1,72 @@
+package wordwrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCountLines(t *testing.T) {
+	inputs := []string{
+		"",
+		"short",
+		"the quick brown fox jumps over the lazy dog",
+		"one two three\n\nfour five six seven eight",
+		"asdasd asd asdasd   \t  asd",
+		"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕",
+		"ab しcd",
+		"ab \U0001F468\u200D\U0001F469 cd",
+	}
+
+	options := [][]SplitBuilderOption{
+		nil,
+		{MaxLines(2)},
+		{MaxLines(2), Ellipsis("...")},
+		{TrimTrailingWhiteSpace(true)},
+		{PreserveNewlines(true), MinLines(4)},
+		{CompactShortLines(6), PreserveNewlines(true)},
+		{ErrorReturnsPartial(false)},
+		{Balanced(true)},
+	}
+
+	for _, input := range inputs {
+		for i, opts := range options {
+			sb := NewSplitBuilder(opts...)
+			lines, wantErr := sb.SplitString(input, 6)
+
+			n, err := sb.CountLines(input, 6)
+			if err != wantErr {
+				t.Errorf(`CountLines(%#v) with options %d error = %v; want %v`, input, i, err, wantErr)
+			}
+
+			if n != len(lines) {
+				t.Errorf(`CountLines(%#v) with options %d = %d; want %d`, input, i, n, len(lines))
+			}
+		}
+	}
+}
+
+func BenchmarkCountLines(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		CountLines(s, 40)
+	}
+}
+
+func BenchmarkCountLines_viaSplitString(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = len(SplitString(s, 40))
+	}
+}
+
+func TestCountLines_allocs(t *testing.T) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 200)
+
+	count := testing.AllocsPerRun(10, func() { CountLines(s, 40) })
+	split := testing.AllocsPerRun(10, func() { SplitString(s, 40) })
+	if count >= split {
+		t.Errorf(`CountLines allocations = %v; want fewer than SplitString's %v`, count, split)
+	}
+}
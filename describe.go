@@ -0,0 +1,293 @@
+package wordwrap
+
+import "strconv"
+
+// String summarizes the options of the SplitBuilder, for logging and
+// debugging. The format is stable and lists every option.
+func (sb *SplitBuilder) String() string {
+	b := make([]byte, 0, 256)
+	b = append(b, "SplitBuilder{"...)
+
+	b = appendUintField(b, "firstLineLimit", sb.firstLineLimit)
+	b = appendBoolField(b, "keepNumberUnitTogether", sb.keepNumberUnitTogether)
+	b = appendBoolField(b, "markdownInlineAware", sb.markdownInlineAware)
+	b = appendBoolField(b, "markdownHardBreaks", sb.markdownHardBreaks)
+
+	b = appendField(b, "strategies")
+	b = append(b, '[')
+	for i, st := range sb.activeStrategies() {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = append(b, st.String()...)
+	}
+	b = append(b, ']')
+
+	b = appendBoolField(b, "oversizeHandler", sb.oversizeHandler != nil)
+	b = appendUintField(b, "breakNearest", sb.breakNearest)
+
+	b = appendField(b, "keepTogether")
+	b = appendStrings(b, sb.keepTogether)
+
+	b = appendBoolField(b, "padLastLine", sb.padLastLine)
+	b = appendBoolField(b, "justify", sb.justify)
+
+	b = appendField(b, "align")
+	b = append(b, sb.align.String()...)
+	b = appendBoolField(b, "balanced", sb.balanced)
+
+	b = appendField(b, "widthMode")
+	b = append(b, sb.widthMode.String()...)
+
+	b = appendBoolField(b, "hyphenator", sb.hyphenator != nil)
+
+	b = appendField(b, "categoryWeights")
+	b = appendWeights(b, sb.categoryWeights)
+
+	b = appendUintField(b, "maxLines", sb.maxLines)
+	b = appendField(b, "ellipsis")
+	b = strconv.AppendQuote(b, sb.ellipsis)
+	b = appendUintField(b, "minLines", sb.minLines)
+	b = appendBoolField(b, "emptyInputYieldsLine", sb.emptyInputLine)
+
+	b = appendField(b, "zeroAdvance")
+	b = append(b, '[')
+	for i, r := range sb.zeroAdvance {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = strconv.AppendQuoteRuneToASCII(b, r)
+	}
+	b = append(b, ']')
+	b = appendUintField(b, "expandTabs", sb.tabWidth)
+	b = appendBoolField(b, "ignoreANSI", sb.ignoreANSI)
+
+	b = appendBoolField(b, "onlyReflowOverLong", sb.onlyReflowOverLong)
+	b = appendBoolField(b, "preserveNewlines", sb.preserveNewlines)
+	b = appendBoolField(b, "whitespaceFunc", sb.whitespaceFunc != nil)
+	b = appendBoolField(b, "breakAfter", sb.breakFunc != nil)
+	b = appendBoolField(b, "coalesceBreakRuns", sb.coalesceBreakRuns)
+	b = appendBoolField(b, "collapseWhitespace", sb.collapseWhitespace)
+	b = appendBoolField(b, "breakOnZeroWidthSpace", sb.zwspBreaks)
+	b = appendBoolField(b, "honorNonBreakingSpace", sb.honorNBSP)
+	b = appendBoolField(b, "breakOnSoftHyphen", sb.softHyphens)
+	b = appendBoolField(b, "breakOnHyphens", sb.hyphenBreaks)
+	b = appendBoolField(b, "useUAX14", sb.uax14)
+
+	b = appendField(b, "boxBorders")
+	if sb.boxed {
+		b = appendStrings(b, []string{sb.boxLeft, sb.boxRight})
+	} else {
+		b = appendStrings(b, nil)
+	}
+
+	b = appendUintField(b, "compactShortLines", sb.compactThreshold)
+	b = appendBoolField(b, "trimTrailingWhiteSpace", sb.trimTrailingWhiteSpace)
+	b = appendBoolField(b, "trimLeadingWhiteSpace", sb.trimLeadingWhiteSpace)
+
+	b = appendField(b, "trimSet")
+	if sb.trimSetOn {
+		b = strconv.AppendQuote(b, sb.trimSet)
+	} else {
+		b = append(b, "whitespace"...)
+	}
+
+	b = appendField(b, "balanceMode")
+	b = append(b, sb.balanceMode.String()...)
+
+	b = appendUintField(b, "emojiWidth", sb.emojiCells())
+
+	b = appendField(b, "mandatoryBreak")
+	if sb.mandatory {
+		b = strconv.AppendQuoteRuneToASCII(b, sb.mandatoryBreak)
+	} else {
+		b = append(b, "none"...)
+	}
+
+	b = appendUintField(b, "hardLimit", sb.hardLimit)
+
+	b = appendField(b, "invalidUTF8")
+	b = append(b, sb.invalidUTF8.String()...)
+
+	b = appendUintField(b, "maxWideCharsPerLine", sb.maxWide)
+	b = appendBoolField(b, "measureAsTransliterated", sb.transliterated)
+	b = appendBoolField(b, "limitFunc", sb.limitFunc != nil)
+	b = appendUintField(b, "reserveTrailing", sb.reserveTrailing)
+	b = appendBoolField(b, "nearLimitCallback", sb.nearLimit != nil)
+
+	b = appendField(b, "baseDirection")
+	b = append(b, sb.baseDirection.String()...)
+	b = appendBoolField(b, "trimVisualEnd", sb.trimVisualEnd)
+
+	b = appendField(b, "lineSeparator")
+	b = strconv.AppendQuote(b, sb.lineSep())
+	b = appendField(b, "softBreakSeparator")
+	b = strconv.AppendQuote(b, sb.separator(line{}))
+
+	b = appendUintField(b, "markOverflowAllowance", sb.markAllowance)
+	b = appendBoolField(b, "autoScript", sb.autoScript)
+
+	b = appendField(b, "firstLinePrefix")
+	b = strconv.AppendQuote(b, sb.firstPrefix)
+	b = appendField(b, "continuationPrefix")
+	b = strconv.AppendQuote(b, sb.prefix)
+	b = appendField(b, "linePrefixes")
+	b = appendStrings(b, sb.linePrefixes)
+
+	b = appendField(b, "breakPriorities")
+	priorities := make(map[string]int, len(sb.breakPriorities))
+	for r, p := range sb.breakPriorities {
+		priorities[strconv.QuoteRuneToASCII(r)] = p
+	}
+	b = appendWeights(b, priorities)
+
+	b = appendBoolField(b, "errorReturnsPartial", !sb.errorDropsPartial)
+
+	b = append(b, '}')
+	return string(b)
+}
+
+// String returns the name of the Strategy.
+func (st Strategy) String() string {
+	switch st {
+	case BreakAtLimit:
+		return "BreakAtLimit"
+	case BreakAtIdentifier:
+		return "BreakAtIdentifier"
+	case ReturnError:
+		return "ReturnError"
+	case Hyphenate:
+		return "Hyphenate"
+	}
+
+	return "Strategy(" + strconv.Itoa(int(st)) + ")"
+}
+
+// String returns the name of the BalanceMode.
+func (m BalanceMode) String() string {
+	switch m {
+	case BalanceInOrder:
+		return "inOrder"
+	case BalanceDecreasing:
+		return "decreasing"
+	case BalanceLines:
+		return "lines"
+	}
+
+	return "BalanceMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// String returns the name of the InvalidUTF8Handling.
+func (h InvalidUTF8Handling) String() string {
+	switch h {
+	case InvalidUTF8Replace:
+		return "replace"
+	case InvalidUTF8Skip:
+		return "skip"
+	case InvalidUTF8Error:
+		return "error"
+	case InvalidUTF8Keep:
+		return "keep"
+	}
+
+	return "InvalidUTF8Handling(" + strconv.Itoa(int(h)) + ")"
+}
+
+// String returns the name of the Alignment.
+func (a Alignment) String() string {
+	switch a {
+	case AlignLeft:
+		return "left"
+	case AlignCenter:
+		return "center"
+	case AlignRight:
+		return "right"
+	}
+
+	return "Alignment(" + strconv.Itoa(int(a)) + ")"
+}
+
+// String returns the name of the Direction.
+func (d Direction) String() string {
+	switch d {
+	case DirectionLTR:
+		return "ltr"
+	case DirectionRTL:
+		return "rtl"
+	case DirectionAuto:
+		return "auto"
+	}
+
+	return "Direction(" + strconv.Itoa(int(d)) + ")"
+}
+
+// String returns the name of the WidthMode.
+func (m WidthMode) String() string {
+	switch m {
+	case MeasureBytes:
+		return "bytes"
+	case MeasureConservative:
+		return "conservative"
+	case MeasureDisplayWidth:
+		return "displayWidth"
+	case MeasureRunes:
+		return "runes"
+	}
+
+	return "WidthMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+func appendField(b []byte, name string) []byte {
+	if b[len(b)-1] != '{' {
+		b = append(b, ", "...)
+	}
+
+	b = append(b, name...)
+	return append(b, ':')
+}
+
+func appendBoolField(b []byte, name string, v bool) []byte {
+	return strconv.AppendBool(appendField(b, name), v)
+}
+
+func appendUintField(b []byte, name string, v uint) []byte {
+	return strconv.AppendUint(appendField(b, name), uint64(v), 10)
+}
+
+func appendStrings(b []byte, v []string) []byte {
+	b = append(b, '[')
+	for i, s := range v {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = strconv.AppendQuote(b, s)
+	}
+
+	return append(b, ']')
+}
+
+func appendWeights(b []byte, v map[string]int) []byte {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+
+	// insertion sort for a stable order without importing sort
+	for i := 1; i < len(keys); i++ {
+		for j := i; j > 0 && keys[j] < keys[j-1]; j-- {
+			keys[j], keys[j-1] = keys[j-1], keys[j]
+		}
+	}
+
+	b = append(b, "map["...)
+	for i, k := range keys {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = append(b, k...)
+		b = append(b, ':')
+		b = strconv.AppendInt(b, int64(v[k]), 10)
+	}
+
+	return append(b, ']')
+}
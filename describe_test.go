@@ -0,0 +1,46 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestSplitBuilder_String(t *testing.T) {
+	tests := []struct {
+		sb     *SplitBuilder
+		output string
+	}{
+		{NewSplitBuilder(),
+			`SplitBuilder{firstLineLimit:0, keepNumberUnitTogether:false, markdownInlineAware:false, markdownHardBreaks:false, strategies:[BreakAtLimit], oversizeHandler:false, breakNearest:0, keepTogether:[], padLastLine:false, justify:false, align:left, balanced:false, widthMode:bytes, hyphenator:false, categoryWeights:map[], maxLines:0, ellipsis:"", minLines:0, emptyInputYieldsLine:false, zeroAdvance:[], expandTabs:0, ignoreANSI:false, onlyReflowOverLong:false, preserveNewlines:false, whitespaceFunc:false, breakAfter:false, coalesceBreakRuns:false, collapseWhitespace:false, breakOnZeroWidthSpace:false, honorNonBreakingSpace:false, breakOnSoftHyphen:false, breakOnHyphens:false, useUAX14:false, boxBorders:[], compactShortLines:0, trimTrailingWhiteSpace:false, trimLeadingWhiteSpace:false, trimSet:whitespace, balanceMode:inOrder, emojiWidth:2, mandatoryBreak:none, hardLimit:0, invalidUTF8:replace, maxWideCharsPerLine:0, measureAsTransliterated:false, limitFunc:false, reserveTrailing:0, nearLimitCallback:false, baseDirection:ltr, trimVisualEnd:false, lineSeparator:"\n", softBreakSeparator:"\n", markOverflowAllowance:0, autoScript:false, firstLinePrefix:"", continuationPrefix:"", linePrefixes:[], breakPriorities:map[], errorReturnsPartial:true}`},
+
+		{NewSplitBuilder(
+			FirstLineLimit(20),
+			KeepNumberUnitTogether(true),
+			MarkdownInlineAware(true),
+			MarkdownHardBreaks(true),
+			BreakStrategy([]Strategy{BreakAtIdentifier, ReturnError}),
+			OversizeHandler(func(string, uint) (string, Action) { return "", ActionSkip }),
+			BreakNearest(3),
+			KeepTogether([]string{"Mr. Smith", "Figure 1"}),
+			PadLastLine(true),
+			MeasureBy(MeasureConservative),
+			WithHyphenator(NewLiangHyphenator(nil)),
+			CategoryWeights(map[string]int{"Wide": 2, "Mn": 0}),
+			MaxLines(5),
+			ZeroAdvanceRunes('\u20dd', '*'),
+			OnlyReflowOverLong(true),
+			WhitespaceFunc(func(r rune) bool { return r == ' ' }),
+			BoxBorders("│ ", " │"),
+			CompactShortLines(8),
+			TrimTrailingWhiteSpace(true),
+			BalanceBy(BalanceDecreasing),
+			EmojiWidth(1),
+		),
+			`SplitBuilder{firstLineLimit:20, keepNumberUnitTogether:true, markdownInlineAware:true, markdownHardBreaks:true, strategies:[BreakAtIdentifier ReturnError], oversizeHandler:true, breakNearest:3, keepTogether:["Mr. Smith" "Figure 1"], padLastLine:true, justify:false, align:left, balanced:false, widthMode:conservative, hyphenator:true, categoryWeights:map[Mn:0 Wide:2], maxLines:5, ellipsis:"", minLines:0, emptyInputYieldsLine:false, zeroAdvance:['\u20dd' '*'], expandTabs:0, ignoreANSI:false, onlyReflowOverLong:true, preserveNewlines:false, whitespaceFunc:true, breakAfter:false, coalesceBreakRuns:false, collapseWhitespace:false, breakOnZeroWidthSpace:false, honorNonBreakingSpace:false, breakOnSoftHyphen:false, breakOnHyphens:false, useUAX14:false, boxBorders:["│ " " │"], compactShortLines:8, trimTrailingWhiteSpace:true, trimLeadingWhiteSpace:false, trimSet:whitespace, balanceMode:decreasing, emojiWidth:1, mandatoryBreak:none, hardLimit:0, invalidUTF8:replace, maxWideCharsPerLine:0, measureAsTransliterated:false, limitFunc:false, reserveTrailing:0, nearLimitCallback:false, baseDirection:ltr, trimVisualEnd:false, lineSeparator:"\n", softBreakSeparator:"\n", markOverflowAllowance:0, autoScript:false, firstLinePrefix:"", continuationPrefix:"", linePrefixes:[], breakPriorities:map[], errorReturnsPartial:true}`},
+	}
+
+	for _, test := range tests {
+		if actual := test.sb.String(); actual != test.output {
+			t.Errorf(`String() = %s; want %s`, actual, test.output)
+		}
+	}
+}
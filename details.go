@@ -0,0 +1,30 @@
+package wordwrap
+
+// SplitDetails holds split lines along with diagnostics about how they were
+// broken, useful for tuning the wrap width.
+type SplitDetails struct {
+	Lines []string
+
+	// BreakOpportunities is the number of places the input could have been
+	// broken, which depends on the active break options. The end of the input
+	// is not counted.
+	BreakOpportunities int
+	// BreaksUsed is the number of lines which ended at a break opportunity.
+	// Hard breaks and words broken by a Strategy are not counted.
+	BreaksUsed int
+}
+
+// SplitDetailed splits s as SplitString does, returning the lines along with
+// break diagnostics.
+func (sb *SplitBuilder) SplitDetailed(s string, byteLimit uint) (SplitDetails, error) {
+	d := SplitDetails{Lines: []string{}}
+	sp := sb.newSplitter(s, byteLimit, func(l line) bool {
+		d.Lines = append(d.Lines, l.text)
+		return true
+	})
+
+	err := sp.run()
+	d.BreakOpportunities, d.BreaksUsed = sp.opportunities, sp.used
+
+	return d, err
+}
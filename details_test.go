@@ -0,0 +1,37 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_SplitDetailed(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  SplitDetails
+		bytelim uint
+	}{
+		{"asdasd asd asdasd",
+			SplitDetails{[]string{"asda", "sd ", "asd ", "asda", "sd"}, 2, 2}, 4},
+
+		{"the quick brown fox jumps",
+			SplitDetails{[]string{"the quick ", "brown fox ", "jumps"}, 4, 2}, 10},
+
+		{"trailing space ",
+			SplitDetails{[]string{"trailing space "}, 1, 0}, 20},
+
+		{"",
+			SplitDetails{[]string{}, 0, 0}, 20},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder().SplitDetailed(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitDetailed(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitDetailed(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// EmojiWidth sets the number of terminal cells an emoji occupies when lines
+// are measured in cells, 1 or 2, to match a specific terminal emulator. The
+// default is 2, as rendered by most modern terminals.
+//
+// A character is an emoji when it starts with a rune drawn as an emoji by
+// default, such as 😀 or a regional indicator of a flag, or contains the emoji
+// presentation selector U+FE0F or the combining keycap U+20E3. Sequences
+// joined by zero-width joiners and emoji with skin tone modifiers count as a
+// single emoji.
+func EmojiWidth(cells uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.emojiWidth = cells
+		sb.asIs = true
+	}
+}
+
+func (sb *SplitBuilder) emojiCells() uint {
+	if sb.emojiWidth == 0 {
+		return 2
+	}
+
+	return sb.emojiWidth
+}
+
+// cells returns the number of terminal cells c occupies, applying EmojiWidth.
+func (sb *SplitBuilder) cells(c string) uint {
+	if isEmoji(c) {
+		return sb.emojiCells()
+	}
+
+	return cellWidth(c)
+}
+
+// isEmoji reports whether the character c is presented as an emoji.
+func isEmoji(c string) bool {
+	r, size := utf8.DecodeRuneInString(c)
+	if inTable(r, emojiTable) {
+		return true
+	}
+
+	for _, r := range c[size:] {
+		if r == '\ufe0f' || r == '\u20e3' {
+			return true
+		}
+	}
+
+	return false
+}
+
+// emojiTable holds the ranges of runes presented as emoji by default, a
+// superset of the Emoji_Presentation property outside of ranges mixing them
+// with text symbols.
+var emojiTable = [][2]rune{
+	{0x1f004, 0x1f004}, {0x1f0cf, 0x1f0cf}, {0x1f18e, 0x1f18e},
+	{0x1f191, 0x1f19a}, {0x1f1e6, 0x1f1ff}, {0x1f201, 0x1f201},
+	{0x1f21a, 0x1f21a}, {0x1f22f, 0x1f22f}, {0x1f232, 0x1f236},
+	{0x1f238, 0x1f23a}, {0x1f250, 0x1f251}, {0x1f300, 0x1f64f},
+	{0x1f680, 0x1f6ff}, {0x1f7e0, 0x1f7eb}, {0x1f90c, 0x1f9ff},
+	{0x1fa70, 0x1faff},
+}
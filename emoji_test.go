@@ -0,0 +1,64 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestCells_clusters(t *testing.T) {
+	tests := []struct {
+		input string
+		width uint
+	}{
+		// modifier sequence
+		{"👋🏽", 2},
+		// zero-width joiner sequences
+		{"👩‍🔬", 2},
+		{"👨‍👩‍👧", 2},
+		{"🏳\ufe0f‍🌈", 2},
+		// keycaps, with and without the presentation selector
+		{"1\ufe0f\u20e3", 2},
+		{"#\u20e3", 2},
+		// flag and tag sequence
+		{"🇺🇸", 2},
+		{"🏴󠁧󠁢󠁥󠁮󠁧󠁿", 2},
+	}
+
+	sb := NewSplitBuilder()
+	for _, test := range tests {
+		if size := charSize(test.input); size != len(test.input) {
+			t.Errorf(`charSize(%#v) = %d; want the whole %d bytes`, test.input, size, len(test.input))
+		}
+
+		if actual := sb.cells(test.input); actual != test.width {
+			t.Errorf(`cells(%#v) = %d; want %d`, test.input, actual, test.width)
+		}
+	}
+}
+
+func TestEmojiWidth(t *testing.T) {
+	tests := []struct {
+		input      string
+		emojiWidth uint
+		width      uint
+	}{
+		{"😀", 0, 2},
+		{"😀", 1, 1},
+		{"😀", 2, 2},
+		{"👩‍🔬", 1, 1},
+		{"👨‍👩‍👧", 1, 1},
+		{"👨‍👩‍👧", 2, 2},
+		{"\U0001F1EF", 1, 1},
+		{"❤", 1, 1},
+		{"❤\ufe0f", 1, 1},
+		{"❤\ufe0f", 2, 2},
+		{"世", 1, 2},
+		{"a", 2, 1},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(EmojiWidth(test.emojiWidth))
+		if actual := sb.cells(test.input); actual != test.width {
+			t.Errorf(`cells(%#v) with EmojiWidth(%d) = %d; want %d`, test.input, test.emojiWidth, actual, test.width)
+		}
+	}
+}
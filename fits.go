@@ -0,0 +1,22 @@
+package wordwrap
+
+// FitsInBox reports whether s wrapped to width fits within height lines.
+// Wrapping stops as soon as the height is exceeded, without processing the
+// rest of s. The width is measured in the active WidthMode.
+//
+// An error is returned if wrapping fails before the height is exceeded.
+func (sb *SplitBuilder) FitsInBox(s string, width, height uint) (bool, error) {
+	var n uint
+	err := sb.split(s, width, func(l line) bool {
+		n++
+		return n <= height
+	})
+
+	return n <= height && err == nil, err
+}
+
+// FitsInBox reports whether s wrapped to a width of bytes fits within height
+// lines, stopping as soon as the height is exceeded.
+func FitsInBox(s string, width, height uint) (bool, error) {
+	return DefaultSplitBuilder.FitsInBox(s, width, height)
+}
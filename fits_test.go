@@ -0,0 +1,30 @@
+package wordwrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFitsInBox(t *testing.T) {
+	tests := []struct {
+		input         string
+		width, height uint
+		fits          bool
+		err           error
+	}{
+		{"", 4, 0, true, nil},
+		{"a", 4, 0, false, nil},
+		{"aaa bbb ccc", 4, 3, true, nil},
+		{"aaa bbb ccc", 4, 2, false, nil},
+		{"aaa bbb ccc", 12, 1, true, nil},
+		{"aaa bbb ccc " + strings.Repeat("し", 1000), 2, 2, false, nil},
+		{"aa し", 2, 5, false, ErrCharacterTooLarge},
+	}
+
+	for _, test := range tests {
+		fits, err := FitsInBox(test.input, test.width, test.height)
+		if fits != test.fits || err != test.err {
+			t.Errorf(`FitsInBox(%#v, %d, %d) = %t, %v; want %t, %v`, test.input, test.width, test.height, fits, err, test.fits, test.err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hyphenator breaks a word into the fragments between which it may be
+// hyphenated, for instance "hy", "phen", "ation" for "hyphenation". The
+// fragments must concatenate back to the word, otherwise they are ignored.
+type Hyphenator interface {
+	Hyphenate(word string) []string
+}
+
+// HyphenatorFunc adapts a function to a Hyphenator, as for hyphenating by
+// fixed rules or wrapping a hyphenation library.
+type HyphenatorFunc func(word string) []string
+
+// Hyphenate returns f(word).
+func (f HyphenatorFunc) Hyphenate(word string) []string {
+	return f(word)
+}
+
+// WithHyphenator sets the Hyphenator consulted by the Hyphenate strategy when
+// a word must be broken. When set and no BreakStrategy is given, words are
+// hyphenated where possible and otherwise broken at the limit.
+//
+// The line is broken at the last hyphenation point at which the start of the
+// word fits along with a trailing "-".
+func WithHyphenator(h Hyphenator) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.hyphenator = h
+		sb.asIs = true
+	}
+}
+
+// hyphenationBreak returns the number of leading characters of the working
+// line ending at the last hyphenation point which fits along with a hyphen, or
+// 0 if there is none.
+func (sp *splitter) hyphenationBreak() int {
+	h := sp.sb.hyphenator
+	if h == nil {
+		return 0
+	}
+
+	// the word continues from any whitespace leading the line, possibly
+	// from a previous line, to the next whitespace of the input
+	first := 0
+	for first < len(sp.chars) && sp.isSpaceAt(sp.chars[first].pos) {
+		first++
+	}
+
+	if first == len(sp.chars) {
+		return 0
+	}
+
+	start := sp.chars[first].pos
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(sp.s[:start])
+		if sp.sb.isSpace(r) {
+			break
+		}
+		start -= size
+	}
+
+	end := sp.chars[first].pos
+	for end < len(sp.s) && !sp.isSpaceAt(end) {
+		_, size := utf8.DecodeRuneInString(sp.s[end:])
+		end += size
+	}
+
+	word := sp.s[start:end]
+	fragments := h.Hyphenate(word)
+	if join(fragments, "") != word {
+		return 0
+	}
+
+	limit := sp.limit()
+	hyphen := sp.sb.measure("-")
+
+	best := 0
+	point := start
+	for _, f := range fragments[:len(fragments)-1] {
+		point += len(f)
+
+		var w uint
+		for n, c := range sp.chars {
+			w += c.width
+			if w+hyphen > limit {
+				break
+			}
+
+			if c.end() == point && n >= first {
+				best = n + 1
+			}
+		}
+	}
+
+	return best
+}
+
+func (sp *splitter) isSpaceAt(i int) bool {
+	r, _ := utf8.DecodeRuneInString(sp.s[i:])
+	return sp.sb.isSpace(r)
+}
+
+// LiangHyphenator is a Hyphenator implementing Liang's algorithm, as used by
+// TeX, over a set of hyphenation patterns.
+type LiangHyphenator struct {
+	patterns map[string][]int
+	maxLen   int
+
+	// LeftMin and RightMin are the fewest letters left before the first and
+	// after the last hyphenation point.
+	LeftMin, RightMin int
+}
+
+// NewLiangHyphenator creates a LiangHyphenator from TeX style patterns such
+// as "hy3ph" or ".ex5am", in which odd digits mark permitted hyphenation
+// points, even digits forbidden ones, and dots the start or end of the word.
+// LeftMin and RightMin default to 2 and 3 as in TeX's English hyphenation.
+func NewLiangHyphenator(patterns []string) *LiangHyphenator {
+	h := &LiangHyphenator{
+		patterns: make(map[string][]int, len(patterns)),
+		LeftMin:  2,
+		RightMin: 3,
+	}
+
+	for _, p := range patterns {
+		letters := []rune{}
+		values := []int{0}
+		for _, r := range p {
+			if r >= '0' && r <= '9' {
+				values[len(values)-1] = int(r - '0')
+				continue
+			}
+
+			letters = append(letters, unicode.ToLower(r))
+			values = append(values, 0)
+		}
+
+		h.patterns[string(letters)] = values
+		if len(letters) > h.maxLen {
+			h.maxLen = len(letters)
+		}
+	}
+
+	return h
+}
+
+// Hyphenate returns the fragments of word between its hyphenation points.
+func (h *LiangHyphenator) Hyphenate(word string) []string {
+	runes := []rune(word)
+
+	w := make([]rune, 0, len(runes)+2)
+	w = append(w, '.')
+	for _, r := range runes {
+		w = append(w, unicode.ToLower(r))
+	}
+	w = append(w, '.')
+
+	points := make([]int, len(w)+1)
+	for i := range w {
+		for j := i + 1; j <= len(w) && j-i <= h.maxLen; j++ {
+			values, ok := h.patterns[string(w[i:j])]
+			if !ok {
+				continue
+			}
+
+			for k, v := range values {
+				if v > points[i+k] {
+					points[i+k] = v
+				}
+			}
+		}
+	}
+
+	fragments := []string{}
+	last := 0
+	for i := h.LeftMin; i <= len(runes)-h.RightMin; i++ {
+		// points[i+1] falls between runes[i-1] and runes[i]
+		if points[i+1]%2 == 1 {
+			fragments = append(fragments, string(runes[last:i]))
+			last = i
+		}
+	}
+
+	return append(fragments, string(runes[last:]))
+}
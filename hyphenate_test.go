@@ -0,0 +1,88 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+type stubHyphenator []string
+
+func (h stubHyphenator) Hyphenate(word string) []string {
+	return h
+}
+
+func TestWithHyphenator(t *testing.T) {
+	everyThree := HyphenatorFunc(func(word string) []string {
+		var fragments []string
+		for len(word) > 3 {
+			fragments, word = append(fragments, word[:3]), word[3:]
+		}
+
+		return append(fragments, word)
+	})
+
+	liang := NewLiangHyphenator([]string{"hy3ph", "he2n", "hena4", "hen5at", "1na", "n2at", "1tio", "2io", "o2n"})
+
+	tests := []struct {
+		input      string
+		hyphenator Hyphenator
+		strategies []Strategy
+		output     []string
+		err        error
+		bytelim    uint
+	}{
+		{"a hyphenation", liang, nil,
+			[]string{"a ", "hyphen-", "ation"}, nil, 8},
+
+		{"a hyphenation", liang, nil,
+			[]string{"a ", "hy-", "phen-", "ation"}, nil, 5},
+
+		{"hyphenation", liang, nil,
+			[]string{"hy-", "phe", "n-", "ati", "on"}, nil, 3},
+
+		{"hyphenation", liang, []Strategy{Hyphenate, ReturnError},
+			[]string{}, ErrWordTooLarge, 2},
+
+		{"abcdef", stubHyphenator{"ab", "cd", "ef"}, nil,
+			[]string{"abcd-", "ef"}, nil, 5},
+
+		{"abcdef", stubHyphenator{"ab", "cd"}, nil,
+			[]string{"abcde", "f"}, nil, 5},
+
+		{"a abcdefghi", everyThree, nil,
+			[]string{"a ", "abc-", "defghi"}, nil, 6},
+
+		{"abcdef", nil, []Strategy{Hyphenate, BreakAtLimit},
+			[]string{"abcde", "f"}, nil, 5},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(WithHyphenator(test.hyphenator), BreakStrategy(test.strategies))
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if !reflect.DeepEqual(actual, test.output) || err != test.err {
+			t.Errorf(`SplitString(%#v) = %#v, %v; want %#v, %v`, test.input, actual, err, test.output, test.err)
+		}
+	}
+}
+
+func TestLiangHyphenator(t *testing.T) {
+	h := NewLiangHyphenator([]string{"hy3ph", "he2n", "hena4", "hen5at", "1na", "n2at", "1tio", "2io", "o2n"})
+
+	tests := []struct {
+		input  string
+		output []string
+	}{
+		{"hyphenation", []string{"hy", "phen", "ation"}},
+		{"Hyphenation", []string{"Hy", "phen", "ation"}},
+		{"nation", []string{"na", "tion"}},
+		{"hen", []string{"hen"}},
+		{"", []string{""}},
+	}
+
+	for _, test := range tests {
+		actual := h.Hyphenate(test.input)
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`Hyphenate(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// BreakOnHyphens makes the hyphens and dashes joining words break
+// opportunities, for technical text such as "client-server-architecture". The
+// line is broken after the hyphen, which stays at the end of the line. The
+// hyphen-minus U+002D, the hyphen U+2010 and the en and em dashes U+2013 and
+// U+2014 break when they follow a letter or digit, so a leading "-" as in
+// "-5" or "--flag" does not.
+//
+// This is simpler than UseUAX14, which also breaks at hyphens, and covers
+// the most common need.
+func BreakOnHyphens(brk bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.hyphenBreaks = brk
+		sb.asIs = true
+	}
+}
+
+// hyphenBreak reports whether the rune r found at byte offset i of s is a
+// hyphen following a word, after which BreakOnHyphens breaks.
+func hyphenBreak(s string, i int, r rune) bool {
+	switch r {
+	case '-', '‐', '–', '—':
+	default:
+		return false
+	}
+
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsLetter(prev) || unicode.IsDigit(prev)
+}
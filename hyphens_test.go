@@ -0,0 +1,40 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakOnHyphens(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"client-server-model",
+			[]string{"client-", "server-", "model"}, 10},
+
+		{"client-server-architecture",
+			[]string{"client-server-", "architecture"}, 16},
+
+		{"a long–term plan",
+			[]string{"a long–", "term plan"}, 10},
+
+		{"use --verbose flag",
+			[]string{"use ", "--verbose ", "flag"}, 10},
+
+		{"-12345678 x",
+			[]string{"-12345678 ", "x"}, 10},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(BreakOnHyphens(true)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package wordwrap
+
+// Justify pads every line broken by wrapping to exactly the limit of its line,
+// for newspaper-style blocks, by distributing spaces as evenly as possible
+// among the gaps between its words, the leftmost gaps taking any remainder.
+// Trailing whitespace of such lines is dropped first.
+//
+// The last line of each paragraph, being the last line or one ending at a
+// hard break, stays ragged, as do lines of a single word, which have no gap
+// to widen, and the indentation at the start of a line. The spaces are
+// added within the width left after the prefix of the line, per LinePrefix,
+// FirstLinePrefix or ContinuationPrefix, which is not widened.
+func Justify(justify bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.justify = justify
+		sb.asIs = true
+	}
+}
+
+// justifyLine widens the gaps between the words of l to fill width.
+func (sb *SplitBuilder) justifyLine(l line, width uint) line {
+	l = sb.trimLine(l)
+
+	w := sb.measure(l.text)
+	if w >= width {
+		return l
+	}
+
+	// gaps holds the offsets of the ends of the runs of whitespace between
+	// words, where padding is inserted
+	gaps := []int{}
+	inWord, inGap := false, false
+	for i, r := range l.text {
+		switch {
+		case sb.isSpace(r):
+			inGap = inWord
+		case inGap:
+			gaps = append(gaps, i)
+			inGap = false
+			fallthrough
+		default:
+			inWord = true
+		}
+	}
+
+	if len(gaps) == 0 {
+		return l
+	}
+
+	extra := int(width - w)
+	b := make([]byte, 0, len(l.text)+extra)
+	prev := 0
+	for n, gap := range gaps {
+		b = append(b, l.text[prev:gap]...)
+		pad := extra / len(gaps)
+		if n < extra%len(gaps) {
+			pad++
+		}
+		for ; pad > 0; pad-- {
+			b = append(b, ' ')
+		}
+		prev = gap
+	}
+	b = append(b, l.text[prev:]...)
+
+	l.text = string(b)
+	return l
+}
@@ -0,0 +1,105 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJustify(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", nil,
+			[]string{"the    quick", "brown    fox", "jumps   over", "the     lazy", "dog"}, 12},
+
+		{"a b c d e f g", nil,
+			[]string{"a  b c d", "e f g"}, 8},
+
+		{"aaa bbbbbbbbbb c", nil,
+			[]string{"aaa", "bbbbbbbbbb", "c"}, 12},
+
+		{"one two three\nfour five six seven", []SplitBuilderOption{PreserveNewlines(true)},
+			[]string{"one     two", "three", "four   five", "six seven"}, 11},
+
+		{"the quick brown fox jumps", []SplitBuilderOption{LinePrefix("> ")},
+			[]string{"> the   quick", "> brown   fox", "> jumps"}, 13},
+
+		{"  indented text wraps here", nil,
+			[]string{"  indented   text", "wraps here"}, 17},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append(test.options, Justify(true))...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestJustify_width(t *testing.T) {
+	input := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua."
+
+	for _, limit := range []uint{20, 31, 40} {
+		sb := NewSplitBuilder(Justify(true), LinePrefix("  "))
+		lines, err := sb.SplitString(input, limit)
+		if err != nil {
+			t.Fatalf(`SplitString(%d) unexpected error: %s`, limit, err)
+		}
+
+		for i, l := range lines[:len(lines)-1] {
+			if !strings.Contains(l[2:], " ") {
+				// a single word has no gap to widen
+				continue
+			}
+
+			if uint(len(l)) != limit {
+				t.Errorf(`SplitString(%d) line %d %#v is %d bytes; want %d`, limit, i, l, len(l), limit)
+			}
+		}
+
+		last := lines[len(lines)-1]
+		if last[len(last)-1] == ' ' {
+			t.Errorf(`SplitString(%d) last line %#v is padded`, limit, last)
+		}
+	}
+}
+
+func TestJustify_indent(t *testing.T) {
+	input := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua."
+
+	options := [][]SplitBuilderOption{
+		{FirstLinePrefix("- "), ContinuationPrefix("    ")},
+		{FirstLinePrefix("        "), ContinuationPrefix("")},
+		{ContinuationPrefix("  │ "), MeasureBy(MeasureDisplayWidth)},
+	}
+
+	for i, opts := range options {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{Justify(true)}, opts...)...)
+		for _, limit := range []uint{20, 31, 40} {
+			lines, err := sb.SplitString(input, limit)
+			if err != nil {
+				t.Fatalf(`SplitString(%d) with options %d unexpected error: %s`, limit, i, err)
+			}
+
+			for n, l := range lines[:len(lines)-1] {
+				if !strings.Contains(strings.TrimLeft(l, " -│"), " ") {
+					// a single word has no gap to widen
+					continue
+				}
+
+				if w := sb.measure(l); w != limit {
+					t.Errorf(`SplitString(%d) with options %d line %d %#v is %d wide; want %d`, limit, i, n, l, w, limit)
+				}
+			}
+		}
+	}
+}
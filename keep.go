@@ -0,0 +1,134 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeepTogether lists phrases such as "Figure 1" or "Mr. Smith" which must not
+// be broken internally.
+//
+// Phrases are matched case-sensitively on word boundaries. Any run of
+// whitespace in a phrase matches any run of whitespace in the input, so
+// "Mr. Smith" matches "Mr.  Smith" or "Mr.\nSmith". Where phrases overlap, the
+// longest phrase matching at the leftmost position wins.
+//
+// A kept phrase only loses its interior break opportunities: if it is longer
+// than a line it is still broken as a long word would be.
+func KeepTogether(phrases []string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.keepTogether = phrases
+		sb.asIs = true
+	}
+}
+
+// longestKept returns the length in bytes of the longest phrase kept
+// together.
+func (sb *SplitBuilder) longestKept() int {
+	n := 0
+	for _, p := range sb.keepTogether {
+		if len(p) > n {
+			n = len(p)
+		}
+	}
+
+	return n
+}
+
+// findPhrases returns the byte ranges of s matched by phrases.
+func findPhrases(s string, phrases []string) [][2]int {
+	if len(phrases) == 0 {
+		return nil
+	}
+
+	var ranges [][2]int
+	for i := 0; i < len(s); {
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if i == 0 || !isAlnum(prev) {
+			longest := 0
+			for _, p := range phrases {
+				if n := matchPhrase(s[i:], p); n > longest {
+					longest = n
+				}
+			}
+
+			if longest > 0 {
+				ranges = append(ranges, [2]int{i, i + longest})
+				i += longest
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	return ranges
+}
+
+// matchPhrase returns the length of the match of phrase at the start of s, or
+// 0 if it doesn't match.
+func matchPhrase(s, phrase string) int {
+	i := 0
+	for j := 0; j < len(phrase); {
+		pr, psize := utf8.DecodeRuneInString(phrase[j:])
+		if unicode.IsSpace(pr) {
+			n := skipSpace(s[i:])
+			if n == 0 {
+				return 0
+			}
+
+			i += n
+			j += skipSpace(phrase[j:])
+			continue
+		}
+
+		if i >= len(s) {
+			return 0
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != pr {
+			return 0
+		}
+
+		i += size
+		j += psize
+	}
+
+	next, _ := utf8.DecodeRuneInString(s[i:])
+	if i == 0 || i < len(s) && isAlnum(next) {
+		return 0
+	}
+
+	return i
+}
+
+func skipSpace(s string) int {
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		i += size
+	}
+
+	return i
+}
+
+// kept reports whether the byte offset i falls inside a phrase kept together.
+func (sp *splitter) kept(i int) bool {
+	for _, k := range sp.keep {
+		if k[0] > i {
+			break
+		}
+
+		if i < k[1] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeepTogether(t *testing.T) {
+	tests := []struct {
+		input   string
+		phrases []string
+		output  []string
+		bytelim uint
+	}{
+		{"as seen in Figure 1 above",
+			[]string{"Figure 1"},
+			[]string{"as seen in ", "Figure 1 above"}, 18},
+
+		{"as seen in Figure 10 above",
+			[]string{"Figure 1"},
+			[]string{"as seen in Figure ", "10 above"}, 18},
+
+		{"ask Mr.  Smith Jr. today",
+			[]string{"Mr. Smith", "Mr. Smith Jr."},
+			[]string{"ask ", "Mr.  Smith Jr. ", "today"}, 18},
+
+		{"ask mr. smith now",
+			[]string{"Mr. Smith"},
+			[]string{"ask mr. ", "smith now"}, 10},
+
+		{"see Mr. Smith",
+			[]string{"Mr. Smith"},
+			[]string{"see ", "Mr. Smi", "th"}, 7},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(KeepTogether(test.phrases)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
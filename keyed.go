@@ -0,0 +1,71 @@
+package wordwrap
+
+import (
+	"hash/fnv"
+	"strconv"
+)
+
+// KeyedLine is a split line along with its position in the input and a key
+// identifying it, for renderers diffing lines across re-wraps.
+type KeyedLine struct {
+	Text string
+
+	// Start and End are the byte offsets in the input of the content of
+	// the line, such that s[Start:End] is the line as in the input before
+	// any trimming, for mapping it back onto spans of the input. Markers
+	// added by options such as MarkdownInlineAware are not part of the
+	// input.
+	Start, End int
+
+	// Trimmed is set when TrimTrailingWhiteSpace or TrimLeadingWhiteSpace
+	// removed whitespace from the end or start of the line, or the Ellipsis
+	// cut the end of the last line to fit, which is only possible when they
+	// are enabled. Text then ends before End or starts after Start.
+	Trimmed bool
+
+	// Key is the hexadecimal 64-bit FNV-1a hash of Start and Text. A line
+	// keeps its Key across re-wraps as long as it begins at the same
+	// offset with the same content.
+	Key string
+
+	// Opportunities holds the byte offsets in the input at which the line
+	// could have been broken, in order, including the one it was broken at
+	// if any. The set depends on the active break options, such as
+	// WhitespaceFunc, KeepTogether and BreakPriorities. The end of the
+	// input is not included.
+	Opportunities []int
+}
+
+// SplitKeyed splits s as SplitString does, returning each line along with its
+// offsets, key and break opportunities.
+func (sb *SplitBuilder) SplitKeyed(s string, byteLimit uint) ([]KeyedLine, error) {
+	lines := []KeyedLine{}
+	sp := sb.newSplitter(s, byteLimit, func(l line) bool {
+		lines = append(lines, KeyedLine{
+			Text:          l.text,
+			Start:         l.start,
+			End:           l.end,
+			Trimmed:       l.trimmed,
+			Key:           lineKey(l.start, l.text),
+			Opportunities: l.opportunities,
+		})
+		return true
+	})
+	sp.collect = true
+
+	err := sp.run()
+
+	return lines, err
+}
+
+func lineKey(start int, text string) string {
+	h := fnv.New64a()
+
+	b := make([]byte, 0, 20+len(text))
+	b = strconv.AppendInt(b, int64(start), 10)
+	b = append(b, ':')
+	b = append(b, text...)
+	h.Write(b)
+
+	return strconv.FormatUint(h.Sum64(), 16)
+}
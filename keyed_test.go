@@ -0,0 +1,117 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitKeyed(t *testing.T) {
+	lines, err := NewSplitBuilder().SplitKeyed("aaa bbb aaa ", 4)
+	if err != nil {
+		t.Fatalf(`SplitKeyed unexpected error: %s`, err)
+	}
+
+	want := []struct {
+		text       string
+		start, end int
+	}{
+		{"aaa ", 0, 4},
+		{"bbb ", 4, 8},
+		{"aaa ", 8, 12},
+	}
+
+	if len(lines) != len(want) {
+		t.Fatalf(`SplitKeyed = %#v; want %d lines`, lines, len(want))
+	}
+
+	for i, w := range want {
+		l := lines[i]
+		if l.Text != w.text || l.Start != w.start || l.End != w.end {
+			t.Errorf(`SplitKeyed line %d = %#v; want %#v at %d-%d`, i, l, w.text, w.start, w.end)
+		}
+	}
+
+	if lines[0].Key == lines[2].Key {
+		t.Errorf(`SplitKeyed equal content at different offsets shares Key %s`, lines[0].Key)
+	}
+
+	rewrapped, _ := NewSplitBuilder().SplitKeyed("aaa bbb aaa ccc", 4)
+	for i := range lines {
+		if rewrapped[i].Key != lines[i].Key {
+			t.Errorf(`SplitKeyed line %d Key = %s after re-wrap; want %s`, i, rewrapped[i].Key, lines[i].Key)
+		}
+	}
+}
+
+func TestSplitKeyed_opportunities(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  [][]int
+		bytelim uint
+	}{
+		// "the quick " | "brown fox"
+		{"the quick brown fox", nil,
+			[][]int{{4, 10}, {16}}, 10},
+
+		// "aaaa" | "a " | "bb"
+		{"aaaaa bb", nil,
+			[][]int{nil, {6}, nil}, 4},
+
+		{"it weighs 5 kg now", []SplitBuilderOption{KeepNumberUnitTogether(true)},
+			[][]int{{3, 10}, {15}}, 12},
+
+		{"ab cd\nef gh", []SplitBuilderOption{OnlyReflowOverLong(true)},
+			[][]int{{3}, {9}}, 10},
+	}
+
+	for _, test := range tests {
+		lines, err := NewSplitBuilder(test.options...).SplitKeyed(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitKeyed(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		actual := [][]int{}
+		for _, l := range lines {
+			actual = append(actual, l.Opportunities)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitKeyed(%#v) opportunities = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitKeyed_spans(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		spans   []string
+		bytelim uint
+	}{
+		{"the quick brown fox", []SplitBuilderOption{TrimTrailingWhiteSpace(true)},
+			[]string{"the quick ", "brown fox"}, 10},
+
+		{"family 👨\u200d👩\u200d👧   and 🇺🇸 flags", []SplitBuilderOption{TrimTrailingWhiteSpace(true)},
+			[]string{"family ", "👨\u200d👩\u200d👧  ", " and 🇺🇸 flags"}, 20},
+
+		{"ab\r\ncd  \nef", []SplitBuilderOption{PreserveNewlines(true), TrimTrailingWhiteSpace(true)},
+			[]string{"ab", "cd  ", "ef"}, 10},
+	}
+
+	for _, test := range tests {
+		lines, err := NewSplitBuilder(test.options...).SplitKeyed(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitKeyed(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		spans := []string{}
+		for _, l := range lines {
+			spans = append(spans, test.input[l.Start:l.End])
+		}
+
+		if !reflect.DeepEqual(spans, test.spans) {
+			t.Errorf(`SplitKeyed(%#v) spans = %#v; want %#v`, test.input, spans, test.spans)
+		}
+	}
+}
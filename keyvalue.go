@@ -0,0 +1,49 @@
+package wordwrap
+
+import "errors"
+
+// ErrNoValueWidth is returned by WrapKeyValue when the key column leaves no
+// room for the value.
+var ErrNoValueWidth = errors.New("wordwrap: key width leaves no room for the value")
+
+// WrapKeyValue lays out key left-aligned in a column keyWidth wide, followed
+// by value wrapped to the remaining width, with the continuation lines of the
+// value indented under the value column, as in CLI help output:
+//
+//	--name   the name of the thing, wrapped
+//	         onto further lines
+//
+// keyWidth includes any gap between the key and the value. A key wider than
+// keyWidth is placed on a line of its own, with the value starting below it.
+// Widths are measured in the active WidthMode, so a mode measuring cells
+// aligns the columns on a terminal. Lines are joined by the LineSeparator.
+func (sb *SplitBuilder) WrapKeyValue(key, value string, keyWidth, totalWidth uint) (string, error) {
+	if totalWidth <= keyWidth {
+		return "", ErrNoValueWidth
+	}
+
+	lines, err := sb.SplitString(value, totalWidth-keyWidth)
+
+	indent := sb.padRight("", keyWidth)
+	out := make([]string, 0, len(lines)+1)
+	if sb.measure(key) > keyWidth {
+		out = append(out, key)
+	} else if len(lines) > 0 {
+		out = append(out, sb.padRight(key, keyWidth)+lines[0])
+		lines = lines[1:]
+	} else {
+		out = append(out, key)
+	}
+
+	for _, l := range lines {
+		out = append(out, indent+l)
+	}
+
+	return join(out, sb.lineSep()), err
+}
+
+// WrapKeyValue lays out key in a column keyWidth bytes wide followed by value
+// wrapped to the remaining width.
+func WrapKeyValue(key, value string, keyWidth, totalWidth uint) (string, error) {
+	return DefaultSplitBuilder.WrapKeyValue(key, value, keyWidth, totalWidth)
+}
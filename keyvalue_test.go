@@ -0,0 +1,33 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestWrapKeyValue(t *testing.T) {
+	tests := []struct {
+		key, value           string
+		keyWidth, totalWidth uint
+		output               string
+		err                  error
+	}{
+		{"--name", "the name of the thing", 9, 20,
+			"--name   the name \n         of the \n         thing", nil},
+
+		{"--verbose-output", "be loud", 9, 20,
+			"--verbose-output\n         be loud", nil},
+
+		{"-v", "", 4, 20,
+			"-v", nil},
+
+		{"-v", "loud", 20, 20,
+			"", ErrNoValueWidth},
+	}
+
+	for _, test := range tests {
+		actual, err := WrapKeyValue(test.key, test.value, test.keyWidth, test.totalWidth)
+		if actual != test.output || err != test.err {
+			t.Errorf(`WrapKeyValue(%#v, %#v) = %#v, %v; want %#v, %v`, test.key, test.value, actual, err, test.output, test.err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package wordwrap
+
+import "sync"
+
+// LazyWrap is a fmt.Stringer which wraps its string only once String is first
+// called, making it cheap to pass to loggers and templates which may never
+// render it.
+type LazyWrap struct {
+	s         string
+	byteLimit uint
+	sb        *SplitBuilder
+
+	once    sync.Once
+	wrapped string
+}
+
+// Lazy returns a LazyWrap wrapping s as a SplitBuilder created with the given
+// options would.
+func Lazy(s string, byteLimit uint, options ...SplitBuilderOption) *LazyWrap {
+	return &LazyWrap{
+		s:         s,
+		byteLimit: byteLimit,
+		sb:        NewSplitBuilder(options...),
+	}
+}
+
+// String returns the wrapped string joined by the LineSeparator, wrapping it
+// on the first call and caching the result for later calls.
+//
+// As String cannot return an error, errors are swallowed: if wrapping fails
+// the lines produced before the failure are returned.
+func (l *LazyWrap) String() string {
+	l.once.Do(func() {
+		_, l.wrapped, _ = l.sb.WrapAndSplit(l.s, l.byteLimit)
+	})
+
+	return l.wrapped
+}
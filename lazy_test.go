@@ -0,0 +1,28 @@
+package wordwrap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  string
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", "asda\nsd \nasd \nasda\nsd", 4},
+		{"ab し", "ab\n ", 2},
+	}
+
+	for _, test := range tests {
+		l := Lazy(test.input, test.bytelim)
+
+		var _ fmt.Stringer = l
+		for i := 0; i < 2; i++ {
+			if actual := l.String(); actual != test.output {
+				t.Errorf(`Lazy(%#v).String() = %#v; want %#v`, test.input, actual, test.output)
+			}
+		}
+	}
+}
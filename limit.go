@@ -0,0 +1,28 @@
+package wordwrap
+
+// LimitFunc sets a function returning the limit of each line from its index
+// and the default limit, which is the limit given when splitting or the
+// FirstLineLimit for the first line. It generalizes FirstLineLimit to ragged
+// shapes and fixed per-line widths.
+//
+// The limit of a line is fixed while the line is produced and cannot change
+// mid-line: fn may be called several times for the same line and must return
+// the same limit for the same index.
+func LimitFunc(fn func(lineIndex int, defaultLimit uint) uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.limitFunc = fn
+		sb.asIs = true
+	}
+}
+
+// ReserveTrailing reserves the last columns of every line, as for a scrollbar
+// or gutter drawn by the caller, so no content or padding enters them. The
+// limit of each line, as set by FirstLineLimit, LimitFunc and the hard limit,
+// is reduced by columns, and the prefixes of LinePrefix and DetectLinePrefix
+// take their share of what remains. Nothing is drawn in the reserved columns.
+func ReserveTrailing(columns uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.reserveTrailing = columns
+		sb.asIs = true
+	}
+}
@@ -0,0 +1,78 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLimitFunc(t *testing.T) {
+	triangle := func(lineIndex int, defaultLimit uint) uint {
+		return uint(lineIndex+1) * 2
+	}
+
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"a bb ccc dddd eeeee", []SplitBuilderOption{LimitFunc(triangle)},
+			[]string{"a ", "bb ", "ccc ", "dddd ", "eeeee"}, 80},
+
+		{"abcdefghijklmnopqrst", []SplitBuilderOption{LimitFunc(triangle)},
+			[]string{"ab", "cdef", "ghijkl", "mnopqrst"}, 80},
+
+		{"aaa bbb ccc ddd", []SplitBuilderOption{FirstLineLimit(4), LimitFunc(func(i int, d uint) uint { return d })},
+			[]string{"aaa ", "bbb ccc ", "ddd"}, 8},
+
+		{"aaa bbb ccc ddd", []SplitBuilderOption{LimitFunc(func(i int, d uint) uint { return d - uint(i%2)*4 })},
+			[]string{"aaa bbb ", "ccc ", "ddd"}, 8},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestReserveTrailing(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", nil, 12},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{PadLastLine(true)}, 12},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{FirstLineLimit(20)}, 12},
+		{"// the quick brown fox\n// jumps over the lazy dog", []SplitBuilderOption{DetectLinePrefix("// ")}, 14},
+		{"abcdefghijklmnopqrstuvwxyz", nil, 8},
+	}
+
+	for _, test := range tests {
+		base := NewSplitBuilder(test.options...)
+		for reserve := uint(0); reserve < 4; reserve++ {
+			sb := NewSplitBuilder(append(test.options, ReserveTrailing(reserve))...)
+			lines, err := sb.SplitString(test.input, test.bytelim)
+			if err != nil {
+				t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+			}
+
+			for i, l := range lines {
+				if sb.measure(l)+reserve > base.limitFor(i, test.bytelim) {
+					t.Errorf(`SplitString(%#v) with ReserveTrailing(%d) line %#v enters the reserved columns`, test.input, reserve, l)
+				}
+			}
+		}
+	}
+
+	lines, _ := NewSplitBuilder(ReserveTrailing(2), PadLastLine(true)).SplitString("aaa bbb cc", 8)
+	if want := []string{"aaa ", "bbb ", "cc    "}; !reflect.DeepEqual(lines, want) {
+		t.Errorf(`SplitString = %#v; want %#v`, lines, want)
+	}
+}
@@ -0,0 +1,51 @@
+package wordwrap
+
+// Lossless configures a SplitBuilder such that concatenating the lines it
+// produces, as Reassemble does, reproduces the input exactly.
+//
+// It disables every option which adds, removes or rewrites content:
+// MarkdownInlineAware, MarkdownHardBreaks, OversizeHandler, PadLastLine,
+// Justify, Align, WithHyphenator, MaxLines, MinLines, OnlyReflowOverLong,
+// PreserveNewlines, BoxBorders, CompactShortLines, TrimTrailingWhiteSpace,
+// TrimLeadingWhiteSpace, TrimVisualEnd, LinePrefix, FirstLinePrefix,
+// ContinuationPrefix, DetectLinePrefix, ExpandTabs, BreakOnZeroWidthSpace,
+// BreakOnSoftHyphen and CollapseWhitespace. Invalid UTF-8 is kept as is.
+// Options applied after Lossless are not checked and may break the invariant.
+func Lossless(lossless bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.asIs = true
+		if !lossless {
+			return
+		}
+
+		sb.markdownInlineAware = false
+		sb.markdownHardBreaks = false
+		sb.oversizeHandler = nil
+		sb.padLastLine = false
+		sb.justify = false
+		sb.align = AlignLeft
+		sb.hyphenator = nil
+		sb.maxLines = 0
+		sb.minLines = 0
+		sb.onlyReflowOverLong = false
+		sb.preserveNewlines = false
+		sb.boxed = false
+		sb.compactThreshold = 0
+		sb.trimTrailingWhiteSpace = false
+		sb.trimLeadingWhiteSpace = false
+		sb.trimVisualEnd = false
+		sb.invalidUTF8 = InvalidUTF8Keep
+		sb.firstPrefix, sb.prefix = "", ""
+		sb.tabWidth = 0
+		sb.zwspBreaks = false
+		sb.softHyphens = false
+		sb.collapseWhitespace = false
+		sb.linePrefixes = nil
+	}
+}
+
+// Reassemble concatenates lines produced by a Lossless SplitBuilder back into
+// the original input.
+func Reassemble(lines []string) string {
+	return join(lines, "")
+}
@@ -0,0 +1,32 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestLossless(t *testing.T) {
+	corpus := []string{
+		"",
+		"asdasd asd asdasd",
+		"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕",
+		"roses are red  \nviolets are blue\\\r\nand **so** are _you_",
+		"tabs\tand non-breaking　spaces  ",
+		"invalid \xff\xfe utf-8 \xe3\x81",
+		`If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die.`,
+		`クラウンの直接土地を保持している任意の伯爵、男爵、または他の人は、兵役のために、死ぬ。`,
+	}
+
+	sb := NewSplitBuilder(MarkdownInlineAware(true), MarkdownHardBreaks(true), Lossless(true))
+	for _, input := range corpus {
+		for lim := uint(4); lim <= 64; lim++ {
+			lines, err := sb.SplitString(input, lim)
+			if err != nil {
+				t.Fatalf(`SplitString(%#v, %d) unexpected error: %s`, input, lim, err)
+			}
+
+			if actual := Reassemble(lines); actual != input {
+				t.Errorf(`Reassemble(SplitString(%#v, %d)) = %#v`, input, lim, actual)
+			}
+		}
+	}
+}
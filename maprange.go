@@ -0,0 +1,69 @@
+package wordwrap
+
+import "errors"
+
+// ErrInvalidRange is returned by MapRange when the range is not within the
+// input or ends before it starts.
+var ErrInvalidRange = errors.New("wordwrap: invalid range")
+
+// RangeOnLine is the part of a range of the input falling on a split line.
+type RangeOnLine struct {
+	// Line is the index of the line.
+	Line int
+
+	// Start and End are the byte offsets of the part in the text of the
+	// line.
+	Start, End int
+}
+
+// MapRange maps the range of s from byte offset start to end onto the lines
+// s is split into, returning the part of the range on each line it
+// intersects, in order. A range spanning several lines is clipped at the
+// boundaries of each, and input left out of every line, such as whitespace
+// trimmed by TrimTrailingWhiteSpace, is left out of the parts. An empty range
+// maps to no parts.
+//
+// The offsets assume the text of a line is its content from the input as is.
+// Options adding to the content of lines, such as the markers reopened by
+// MarkdownInlineAware, shift the parts following the additions.
+func (sb *SplitBuilder) MapRange(s string, byteLimit uint, start, end int) ([]RangeOnLine, error) {
+	if start < 0 || end < start || end > len(s) {
+		return nil, ErrInvalidRange
+	}
+
+	ranges := []RangeOnLine{}
+	if start == end {
+		return ranges, nil
+	}
+
+	err := sb.split(s, byteLimit, func(l line) bool {
+		if l.start >= end {
+			return false
+		}
+
+		from, to := start-l.start, end-l.start
+		if from < 0 {
+			from = 0
+		}
+		if max := l.end - l.start; to > max {
+			to = max
+		}
+		if to > len(l.text) {
+			to = len(l.text)
+		}
+
+		if from < to {
+			ranges = append(ranges, RangeOnLine{Line: l.index, Start: from, End: to})
+		}
+
+		return true
+	})
+
+	return ranges, err
+}
+
+// MapRange maps the range of s from byte offset start to end onto the lines
+// SplitString splits s into.
+func MapRange(s string, byteLimit uint, start, end int) ([]RangeOnLine, error) {
+	return DefaultSplitBuilder.MapRange(s, byteLimit, start, end)
+}
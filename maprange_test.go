@@ -0,0 +1,51 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_MapRange(t *testing.T) {
+	tests := []struct {
+		input      string
+		options    []SplitBuilderOption
+		start, end int
+		output     []RangeOnLine
+		err        error
+		bytelim    uint
+	}{
+		// "the quick " | "brown fox"
+		{"the quick brown fox", nil, 4, 9,
+			[]RangeOnLine{{0, 4, 9}}, nil, 10},
+
+		{"the quick brown fox", nil, 4, 15,
+			[]RangeOnLine{{0, 4, 10}, {1, 0, 5}}, nil, 10},
+
+		// "aaa " | "bbb " | "ccc"
+		{"aaa bbb ccc", nil, 2, 10,
+			[]RangeOnLine{{0, 2, 4}, {1, 0, 4}, {2, 0, 2}}, nil, 4},
+
+		{"aaa bbb ccc", []SplitBuilderOption{TrimTrailingWhiteSpace(true)}, 3, 5,
+			[]RangeOnLine{{1, 0, 1}}, nil, 4},
+
+		{"aaa bbb ccc", nil, 5, 5,
+			[]RangeOnLine{}, nil, 4},
+
+		{"aaa bbb ccc", nil, 5, 4,
+			nil, ErrInvalidRange, 4},
+
+		{"aaa bbb ccc", nil, 0, 12,
+			nil, ErrInvalidRange, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).MapRange(test.input, test.bytelim, test.start, test.end)
+		if err != test.err {
+			t.Errorf(`MapRange(%#v, %d, %d) error = %v; want %v`, test.input, test.start, test.end, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`MapRange(%#v, %d, %d) = %#v; want %#v`, test.input, test.start, test.end, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownInlineAware keeps Markdown inline markup balanced on every line.
+//
+// Spans opened by `*`, `_`, `**`, `__` or backticks which are still open at a
+// break are closed at the end of the line, ahead of any trailing whitespace,
+// and reopened at the start of the next line, so each line is independently
+// well-formed. The inserted markers count towards the limit of their line.
+//
+// Markers are matched by simple toggling: nesting is not validated, an
+// underscore between two letters or digits is treated as text, and markup
+// inside code spans is ignored. Spans left open at the end of the input are
+// not closed.
+func MarkdownInlineAware(aware bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.markdownInlineAware = aware
+		sb.asIs = true
+	}
+}
+
+// mdToggle returns the open spans after the character at s[pos:] given the
+// spans open before it. The given slice is never modified.
+func mdToggle(open []string, s string, pos int) []string {
+	m := s[pos]
+	if m != '*' && m != '_' && m != '`' {
+		return open
+	}
+
+	if pos > 0 && s[pos-1] == m {
+		// already handled at the start of the run
+		return open
+	}
+
+	end := pos + 1
+	for end < len(s) && s[end] == m {
+		end++
+	}
+
+	run := s[pos:end]
+	if m == '`' {
+		return mdToggleSpan(open, run)
+	}
+
+	if len(open) > 0 && open[len(open)-1][0] == '`' {
+		return open
+	}
+
+	if m == '_' {
+		before, _ := utf8.DecodeLastRuneInString(s[:pos])
+		after, _ := utf8.DecodeRuneInString(s[end:])
+		if isAlnum(before) && isAlnum(after) {
+			return open
+		}
+	}
+
+	if len(run) >= 2 {
+		open = mdToggleSpan(open, run[:2])
+	}
+	if len(run) != 2 {
+		open = mdToggleSpan(open, run[:1])
+	}
+
+	return open
+}
+
+func mdToggleSpan(open []string, marker string) []string {
+	out := make([]string, 0, len(open)+1)
+	for _, o := range open {
+		if o != marker {
+			out = append(out, o)
+		}
+	}
+
+	if len(out) == len(open) {
+		out = append(out, marker)
+	}
+
+	return out
+}
+
+func isAlnum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// mdOpeners returns the markers reopening the given spans.
+func mdOpeners(open []string) string {
+	s := ""
+	for _, o := range open {
+		s += o
+	}
+
+	return s
+}
+
+// mdClosers returns the markers closing the given spans.
+func mdClosers(open []string) string {
+	s := ""
+	for i := len(open) - 1; i >= 0; i-- {
+		s += open[i]
+	}
+
+	return s
+}
+
+// mdClose inserts closers into line ahead of any trailing whitespace.
+func mdClose(line, closers string) string {
+	end := len(line)
+	for end > 0 {
+		r, size := utf8.DecodeLastRuneInString(line[:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		end -= size
+	}
+
+	return line[:end] + closers + line[end:]
+}
+
+// MarkdownHardBreaks treats a line ending in two or more spaces or a backslash
+// as a Markdown hard line break.
+//
+// The line is always broken there and the marker is kept at the end of the
+// line, even with TrimTrailingWhiteSpace, while the newline itself is dropped
+// as with any other break. Lines are never reflowed past a hard break and no
+// break is placed inside the trailing spaces of the marker while another
+// break opportunity fits.
+func MarkdownHardBreaks(hard bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.markdownHardBreaks = hard
+		sb.asIs = true
+	}
+}
+
+// isMarkdownHardBreak reports whether the newline at s[i] ends a line with a
+// Markdown hard break marker.
+func isMarkdownHardBreak(s string, i int) bool {
+	line := s[:i]
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		line = line[:len(line)-1]
+	}
+
+	n := len(line)
+	return n >= 1 && line[n-1] == '\\' || n >= 2 && line[n-2:] == "  "
+}
+
+// isMarkdownHardBreakSpace reports whether the space at s[i] is part of a
+// Markdown hard break marker.
+func isMarkdownHardBreakSpace(s string, i int) bool {
+	end := i
+	for end < len(s) && s[end] == ' ' {
+		end++
+	}
+
+	start := i
+	for start > 0 && s[start-1] == ' ' {
+		start--
+	}
+
+	rest := s[end:]
+	if len(rest) > 0 && rest[0] == '\r' {
+		rest = rest[1:]
+	}
+
+	return end-start >= 2 && len(rest) > 0 && rest[0] == '\n'
+}
@@ -0,0 +1,76 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkdownInlineAware(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"some **very bold text** here",
+			[]string{"some **very** ", "**bold text** ", "here"}, 14},
+
+		{"an _emphasized phrase_ and `some code here`",
+			[]string{"an _emphasized_ ", "_phrase_ and ", "`some code` ", "`here`"}, 16},
+
+		{"snake_case_name and *a b*",
+			[]string{"snake_case_name ", "and *a b*"}, 16},
+
+		{"`a_b c_d` end",
+			[]string{"`a_b` ", "`c_d` ", "end"}, 7},
+	}
+
+	sb := NewSplitBuilder(MarkdownInlineAware(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		for _, line := range actual {
+			if uint(len(line)) > test.bytelim {
+				t.Errorf(`SplitString(%#v) line %#v exceeds %d bytes`, test.input, line, test.bytelim)
+			}
+		}
+	}
+}
+
+func TestMarkdownHardBreaks(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"roses are red  \nviolets are blue",
+			[]string{"roses are red  ", "violets are ", "blue"}, 16},
+
+		{"one\\\ntwo three\r\nfour  \r\nfive",
+			[]string{"one\\", "two three\r\nfour  ", "five"}, 20},
+
+		{"soft\nbreak here",
+			[]string{"soft\nbreak ", "here"}, 12},
+
+		{"ab cd  \nef",
+			[]string{"ab ", "cd  ", "ef"}, 6},
+	}
+
+	sb := NewSplitBuilder(MarkdownHardBreaks(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkOverflowAllowance reserves allowance extra width for each character
+// carrying a stack of two or more combining marks, as found in Thai or
+// Vietnamese, whose marks may extend past the cell of their base and collide
+// with the right margin even though the line measures within its limit.
+//
+// This is an approximation: the allowance counts toward the width of the
+// line when breaking it, so lines holding such stacks are broken earlier,
+// but the marks themselves are not measured. An allowance of 0, the default,
+// disables it.
+func MarkOverflowAllowance(allowance uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.markAllowance = allowance
+		sb.asIs = allowance == 0
+	}
+}
+
+// markAllowanceIn returns the allowance for the character of size bytes found
+// at byte offset i of s, which applies once per stack, to the character
+// holding its second combining mark. The marks of a stack are characters of
+// their own with MeasureBytes, and part of the character of their base with
+// MeasureRunes or MeasureDisplayWidth.
+func (sb *SplitBuilder) markAllowanceIn(s string, i, size int) uint {
+	if sb.markAllowance == 0 {
+		return 0
+	}
+
+	marks := 0
+	for j := i; j > 0 && marks < 2; {
+		prev, size := utf8.DecodeLastRuneInString(s[:j])
+		if !unicode.Is(unicode.Mn, prev) {
+			break
+		}
+
+		marks++
+		j -= size
+	}
+
+	for _, r := range s[i : i+size] {
+		if !unicode.Is(unicode.Mn, r) {
+			marks = 0
+			continue
+		}
+
+		if marks++; marks == 2 {
+			return sb.markAllowance
+		}
+	}
+
+	return 0
+}
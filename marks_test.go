@@ -0,0 +1,77 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkOverflowAllowance(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"ab a\u0301\u0302 cd", nil,
+			[]string{"ab a\u0301\u0302 cd"}, 12},
+
+		{"ab a\u0301\u0302 cd", []SplitBuilderOption{MarkOverflowAllowance(2)},
+			[]string{"ab a\u0301\u0302 ", "cd"}, 12},
+
+		{"ab a\u0301 cd", []SplitBuilderOption{MarkOverflowAllowance(2)},
+			[]string{"ab a\u0301 cd"}, 12},
+
+		{"a\u0301\u0302\u0303 a\u0301\u0302\u0303", []SplitBuilderOption{MarkOverflowAllowance(1)},
+			[]string{"a\u0301\u0302\u0303 ", "a\u0301\u0302\u0303"}, 16},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestMarkOverflowAllowance_widthModes(t *testing.T) {
+	tests := []struct {
+		mode    WidthMode
+		output  []string
+		without []string
+		bytelim uint
+	}{
+		{MeasureBytes,
+			[]string{"ab e\u0301\u0302 ", "cd"}, []string{"ab e\u0301\u0302 cd"}, 12},
+
+		{MeasureConservative,
+			[]string{"ab e\u0301\u0302 ", "cd"}, []string{"ab e\u0301\u0302 cd"}, 12},
+
+		{MeasureRunes,
+			[]string{"ab e\u0301\u0302 ", "cd"}, []string{"ab e\u0301\u0302 cd"}, 8},
+
+		{MeasureDisplayWidth,
+			[]string{"ab e\u0301\u0302 ", "cd"}, []string{"ab e\u0301\u0302 cd"}, 8},
+	}
+
+	for _, test := range tests {
+		input := "ab e\u0301\u0302 cd"
+
+		actual, err := NewSplitBuilder(MeasureBy(test.mode), MarkOverflowAllowance(2)).SplitString(input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) with %s unexpected error: %s`, input, test.mode, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) with %s = %#v; want %#v`, input, test.mode, actual, test.output)
+		}
+
+		actual, _ = NewSplitBuilder(MeasureBy(test.mode)).SplitString(input, test.bytelim)
+		if !reflect.DeepEqual(actual, test.without) {
+			t.Errorf(`SplitString(%#v) with %s and no allowance = %#v; want %#v`, input, test.mode, actual, test.without)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package wordwrap
+
+import "errors"
+
+// ErrTruncated is returned by streaming APIs once MaxLines lines have been
+// produced and input remains, signaling the producer to stop.
+var ErrTruncated = errors.New("wordwrap: line limit reached")
+
+// MaxLines caps the number of lines produced. Once n lines have been produced
+// the rest of the input is not processed. A limit of 0 disables the cap.
+//
+// SplitString returns the lines within the cap without error, Wrap reports the
+// truncation in WrappedText.Truncated, ScanWrappedLines stops its Scanner
+// with ErrTruncated, and a Writer or the reader of NewReader return
+// ErrTruncated.
+func MaxLines(n uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.maxLines = n
+		sb.asIs = true
+	}
+}
+
+// Ellipsis sets a marker, such as "…", appended to the last line when MaxLines
+// truncates the output, for previews which should show that more text
+// follows. The line is trimmed of its trailing whitespace, and of as many
+// characters as needed for the marker to fit within its limit. Output which
+// fits within MaxLines is left as is.
+//
+// If the marker alone is wider than the limit of the line, it is left out.
+func Ellipsis(marker string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.ellipsis = marker
+		sb.asIs = true
+	}
+}
+
+// ellipsize appends the Ellipsis to l, the last line of truncated output,
+// trimming it so the marker fits within its limit.
+func (sb *SplitBuilder) ellipsize(l line, byteLimit uint) line {
+	if sb.ellipsis == "" {
+		return l
+	}
+
+	limit := sb.limitFor(l.index, byteLimit)
+	if p := sb.measure(sb.linePrefixOf(l)); p < limit {
+		limit -= p
+	} else {
+		limit = 0
+	}
+
+	width := sb.measure(sb.ellipsis)
+	if width > limit {
+		return l
+	}
+
+	kept := sb.prefixWithin(sb.trimLine(l).text, limit-width)
+	l.text, l.trimmed = kept+sb.ellipsis, l.trimmed || len(kept) < len(l.text)
+
+	return l
+}
@@ -0,0 +1,199 @@
+package wordwrap
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMaxLines(t *testing.T) {
+	tests := []struct {
+		input     string
+		options   []SplitBuilderOption
+		output    []string
+		truncated bool
+		bytelim   uint
+	}{
+		{"aaa bbb ccc ddd", []SplitBuilderOption{MaxLines(2)},
+			[]string{"aaa ", "bbb "}, true, 4},
+
+		{"aaa bbb", []SplitBuilderOption{MaxLines(2)},
+			[]string{"aaa ", "bbb"}, false, 4},
+
+		{"aaa bbb", []SplitBuilderOption{MaxLines(3)},
+			[]string{"aaa ", "bbb"}, false, 4},
+
+		{"aaa bbb ccc", []SplitBuilderOption{MaxLines(0)},
+			[]string{"aaa ", "bbb ", "ccc"}, false, 4},
+
+		{"aaa bbb ccc", []SplitBuilderOption{MaxLines(1), PadLastLine(true)},
+			[]string{"aaa "}, true, 4},
+
+		{"a bbb ccc", []SplitBuilderOption{MaxLines(1), PadLastLine(true)},
+			[]string{"a   "}, true, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := Wrap(test.input, test.bytelim, test.options...)
+		if err != nil {
+			t.Fatalf(`Wrap(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual.Lines, test.output) || actual.Truncated != test.truncated {
+			t.Errorf(`Wrap(%#v) = %#v; want %#v, truncated %t`, test.input, actual, test.output, test.truncated)
+		}
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+func TestScanWrappedLines_maxLines(t *testing.T) {
+	input := strings.Repeat("lorem ipsum dolor sit amet ", 100000)
+	r := &countingReader{r: strings.NewReader(input)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(ScanWrappedLines(20, MaxLines(3)))
+
+	actual := []string{}
+	for scanner.Scan() {
+		actual = append(actual, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != ErrTruncated {
+		t.Errorf(`Scan error = %v; want %v`, err, ErrTruncated)
+	}
+
+	if want := []string{"lorem ipsum dolor ", "sit amet lorem ", "ipsum dolor sit "}; !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Scan = %#v; want %#v`, actual, want)
+	}
+
+	if r.n >= len(input) {
+		t.Errorf(`Scan read the whole input of %d bytes`, len(input))
+	}
+}
+
+func TestMaxLines_stopsReading(t *testing.T) {
+	input := strings.Repeat("lorem ipsum dolor sit amet ", 100000)
+
+	lines := 0
+	sp := NewSplitBuilder(MaxLines(3)).newSplitter(input, 20, func(l line) bool {
+		lines++
+		return true
+	})
+
+	if err := sp.run(); err != nil {
+		t.Fatalf(`split unexpected error: %s`, err)
+	}
+
+	if lines != 3 || !sp.truncated {
+		t.Errorf(`split produced %d lines, truncated %t; want 3, true`, lines, sp.truncated)
+	}
+
+	if sp.read > 100 {
+		t.Errorf(`split read %d bytes of the input; want it to stop after the fourth line began`, sp.read)
+	}
+}
+
+func TestEllipsis(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{MaxLines(2), Ellipsis("...")},
+			[]string{"the quick ", "brown f..."}, 10},
+
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{MaxLines(2), Ellipsis("…"), MeasureBy(MeasureRunes)},
+			[]string{"the quick ", "brown fox…"}, 10},
+
+		{"the quick brown fox", []SplitBuilderOption{MaxLines(2), Ellipsis("...")},
+			[]string{"the quick ", "brown fox"}, 10},
+
+		{"the quick brown fox", []SplitBuilderOption{MaxLines(3), Ellipsis("...")},
+			[]string{"the quick ", "brown fox"}, 10},
+
+		{"abcdefghij", []SplitBuilderOption{MaxLines(1), Ellipsis("...")},
+			[]string{"a..."}, 4},
+
+		{"abcdefghij", []SplitBuilderOption{MaxLines(1), Ellipsis(".....")},
+			[]string{"abcd"}, 4},
+
+		{"aaa bbb ccc ddd", []SplitBuilderOption{MaxLines(2), Ellipsis("~"), PadLastLine(true)},
+			[]string{"aaa ", "bbb~"}, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestEllipsis_wrap(t *testing.T) {
+	text := strings.Repeat("the quick brown fox jumps over the lazy dog ", 10)
+	sb := NewSplitBuilder(MaxLines(3), Ellipsis("…"), MeasureBy(MeasureRunes))
+
+	wrapped, err := sb.Wrap(text, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(wrapped, "\n")
+	if len(lines) != 3 || !strings.HasSuffix(lines[2], "…") {
+		t.Fatalf(`Wrap = %#v; want 3 lines, the last ending in an ellipsis`, wrapped)
+	}
+
+	for _, l := range lines {
+		if w := sb.measure(l); w > 40 {
+			t.Errorf(`Wrap line %#v is %d wide; want at most 40`, l, w)
+		}
+	}
+}
+
+func TestEllipsis_trimmed(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		text    string
+		trimmed bool
+		bytelim uint
+	}{
+		{"ab\ncd", []SplitBuilderOption{PreserveNewlines(true)},
+			"ab...", false, 10},
+
+		{"abcdefgh ij", nil,
+			"abcde...", true, 8},
+
+		{"abc def ghi", nil,
+			"abc d...", true, 8},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append(test.options, MaxLines(1), Ellipsis("..."))...)
+		lines, err := sb.SplitKeyed(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitKeyed(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if len(lines) != 1 || lines[0].Text != test.text || lines[0].Trimmed != test.trimmed {
+			t.Errorf(`SplitKeyed(%#v) = %#v; want text %#v, trimmed %t`, test.input, lines, test.text, test.trimmed)
+		}
+	}
+}
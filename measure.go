@@ -0,0 +1,206 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// WidthMode is the unit in which a SplitBuilder measures lines against their
+// limit.
+type WidthMode int
+
+const (
+	// MeasureBytes measures lines in UTF-8 bytes. This is the default.
+	MeasureBytes WidthMode = iota
+	// MeasureConservative measures each character as the widest of its
+	// UTF-8 bytes, runes and terminal cells, such that lines fit within the
+	// limit however a consumer measures them.
+	//
+	// This wraps aggressively by design. As UTF-8 is never narrower than
+	// the runes or cells it encodes, lines are currently wrapped as
+	// MeasureBytes would wrap them.
+	MeasureConservative
+	// MeasureDisplayWidth measures lines in monospace terminal cells, such
+	// that the limit is a number of console columns. East Asian wide and
+	// fullwidth characters occupy two cells, emoji as many as EmojiWidth
+	// sets, combining marks and control characters none, and everything
+	// else one. Combining marks are kept on the line of the character they
+	// follow.
+	MeasureDisplayWidth
+	// MeasureRunes measures lines in user-perceived characters, such that
+	// the limit is a character count. A character with combining marks, as
+	// "e\u0301", counts as one, as do emoji sequences and flags.
+	MeasureRunes
+)
+
+// MeasureBy sets the unit in which lines are measured against their limit.
+func MeasureBy(mode WidthMode) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.widthMode = mode
+		sb.asIs = true
+	}
+}
+
+// measure returns the width of s in the active WidthMode.
+func (sb *SplitBuilder) measure(s string) uint {
+	var w uint
+	for i := 0; i < len(s); {
+		size := sb.charSize(s[i:])
+		w += sb.charWidth(s[i : i+size])
+		i += size
+	}
+
+	return w
+}
+
+// charWidth returns the width of a single character in the active WidthMode,
+// or by CategoryWeights if set.
+func (sb *SplitBuilder) charWidth(c string) uint {
+	if sb.ignoreANSI && csiSize(c) > 0 {
+		return 0
+	}
+
+	if sb.zeroAdvance != nil {
+		if c = sb.stripZeroAdvance(c); c == "" {
+			return 0
+		}
+	}
+
+	if sb.transliterated {
+		return transliteratedWidth(c)
+	}
+
+	if sb.categoryWeights != nil {
+		return sb.categoryWidth(c)
+	}
+
+	if sb.widthMode == MeasureDisplayWidth {
+		return sb.cells(c)
+	}
+
+	if sb.widthMode == MeasureRunes {
+		return 1
+	}
+
+	if sb.widthMode == MeasureConservative {
+		w := uint(len(c))
+		if n := uint(utf8.RuneCountInString(c)); n > w {
+			w = n
+		}
+		if n := sb.cells(c); n > w {
+			w = n
+		}
+
+		return w
+	}
+
+	return uint(len(c))
+}
+
+// charSize returns the byte length of the character at the start of s, which
+// with MeasureRunes and MeasureDisplayWidth takes in the combining marks
+// following it, such that they are counted and kept together with it. With
+// IgnoreANSI an escape sequence is a character of its own.
+func (sb *SplitBuilder) charSize(s string) int {
+	if sb.ignoreANSI {
+		if n := csiSize(s); n > 0 {
+			return n
+		}
+	}
+
+	size := charSize(s)
+	if sb.widthMode != MeasureRunes && sb.widthMode != MeasureDisplayWidth {
+		return size
+	}
+
+	for size < len(s) {
+		r, n := utf8.DecodeRuneInString(s[size:])
+		if !unicode.In(r, unicode.Mn, unicode.Me) {
+			break
+		}
+		size += n
+	}
+
+	return size
+}
+
+// cellWidth returns the number of monospace terminal cells a character
+// occupies, which is the width of its first rune. A narrow symbol followed by
+// the emoji presentation selector occupies two cells.
+func cellWidth(c string) uint {
+	r, size := utf8.DecodeRuneInString(c)
+
+	w := runeCellWidth(r)
+	if w == 1 {
+		for _, r := range c[size:] {
+			if r == '\ufe0f' {
+				return 2
+			}
+		}
+	}
+
+	return w
+}
+
+func runeCellWidth(r rune) uint {
+	switch {
+	case r == 0,
+		unicode.Is(unicode.Cc, r),
+		unicode.Is(unicode.Mn, r),
+		unicode.Is(unicode.Me, r),
+		unicode.Is(unicode.Cf, r),
+		r >= 0x1160 && r <= 0x11ff:
+		return 0
+	case inTable(r, wideTable):
+		return 2
+	}
+
+	return 1
+}
+
+func inTable(r rune, table [][2]rune) bool {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		m := (lo + hi) / 2
+		switch {
+		case r < table[m][0]:
+			hi = m
+		case r > table[m][1]:
+			lo = m + 1
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// wideTable holds the ranges of East Asian Wide and Fullwidth characters along
+// with emoji presented as wide by default, per Unicode 15.
+var wideTable = [][2]rune{
+	{0x1100, 0x115f}, {0x231a, 0x231b}, {0x2329, 0x232a}, {0x23e9, 0x23ec},
+	{0x23f0, 0x23f0}, {0x23f3, 0x23f3}, {0x25fd, 0x25fe}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267f, 0x267f}, {0x2693, 0x2693}, {0x26a1, 0x26a1},
+	{0x26aa, 0x26ab}, {0x26bd, 0x26be}, {0x26c4, 0x26c5}, {0x26ce, 0x26ce},
+	{0x26d4, 0x26d4}, {0x26ea, 0x26ea}, {0x26f2, 0x26f3}, {0x26f5, 0x26f5},
+	{0x26fa, 0x26fa}, {0x26fd, 0x26fd}, {0x2705, 0x2705}, {0x270a, 0x270b},
+	{0x2728, 0x2728}, {0x274c, 0x274c}, {0x274e, 0x274e}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27b0, 0x27b0}, {0x27bf, 0x27bf},
+	{0x2b1b, 0x2b1c}, {0x2b50, 0x2b50}, {0x2b55, 0x2b55}, {0x2e80, 0x303e},
+	{0x3041, 0x33ff}, {0x3400, 0x4dbf}, {0x4e00, 0x9fff}, {0xa000, 0xa4cf},
+	{0xa960, 0xa97f}, {0xac00, 0xd7a3}, {0xf900, 0xfaff}, {0xfe10, 0xfe19},
+	{0xfe30, 0xfe6f}, {0xff00, 0xff60}, {0xffe0, 0xffe6}, {0x16fe0, 0x16fe4},
+	{0x17000, 0x18cff}, {0x1aff0, 0x1b2ff}, {0x1f004, 0x1f004}, {0x1f0cf, 0x1f0cf},
+	{0x1f18e, 0x1f18e}, {0x1f191, 0x1f19a}, {0x1f200, 0x1f202}, {0x1f210, 0x1f23b},
+	{0x1f240, 0x1f248}, {0x1f250, 0x1f251}, {0x1f260, 0x1f265}, {0x1f300, 0x1f320},
+	{0x1f32d, 0x1f335}, {0x1f337, 0x1f37c}, {0x1f37e, 0x1f393}, {0x1f3a0, 0x1f3ca},
+	{0x1f3cf, 0x1f3d3}, {0x1f3e0, 0x1f3f0}, {0x1f3f4, 0x1f3f4}, {0x1f3f8, 0x1f43e},
+	{0x1f440, 0x1f440}, {0x1f442, 0x1f4fc}, {0x1f4ff, 0x1f53d}, {0x1f54b, 0x1f54e},
+	{0x1f550, 0x1f567}, {0x1f57a, 0x1f57a}, {0x1f595, 0x1f596}, {0x1f5a4, 0x1f5a4},
+	{0x1f5fb, 0x1f64f}, {0x1f680, 0x1f6c5}, {0x1f6cc, 0x1f6cc}, {0x1f6d0, 0x1f6d2},
+	{0x1f6d5, 0x1f6d7}, {0x1f6dc, 0x1f6df}, {0x1f6eb, 0x1f6ec}, {0x1f6f4, 0x1f6fc},
+	{0x1f7e0, 0x1f7eb}, {0x1f7f0, 0x1f7f0}, {0x1f90c, 0x1f93a}, {0x1f93c, 0x1f945},
+	{0x1f947, 0x1f9ff}, {0x1fa70, 0x1fa7c}, {0x1fa80, 0x1fa88}, {0x1fa90, 0x1fabd},
+	{0x1fabf, 0x1fac5}, {0x1face, 0x1fadb}, {0x1fae0, 0x1fae8}, {0x1faf0, 0x1faf8},
+	{0x20000, 0x2fffd}, {0x30000, 0x3fffd},
+}
@@ -0,0 +1,135 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMeasureConservative(t *testing.T) {
+	inputs := []string{
+		"asdasd asd asdasd",
+		"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕",
+		"Hello, 世界! 👋 ｆｕｌｌｗｉｄｔｈ",
+		`クラウンの直接土地を保持している任意の伯爵、男爵、または他の人は、兵役のために、死ぬ。`,
+	}
+
+	conservative := NewSplitBuilder(MeasureBy(MeasureConservative))
+	for _, input := range inputs {
+		for lim := uint(4); lim < 40; lim++ {
+			want, _ := NewSplitBuilder().SplitString(input, lim)
+			actual, err := conservative.SplitString(input, lim)
+			if err != nil {
+				t.Fatalf(`SplitString(%#v, %d) unexpected error: %s`, input, lim, err)
+			}
+
+			if !reflect.DeepEqual(actual, want) {
+				t.Errorf(`SplitString(%#v, %d) = %#v; want %#v`, input, lim, actual, want)
+			}
+		}
+	}
+}
+
+func TestCellWidth(t *testing.T) {
+	tests := []struct {
+		input string
+		width uint
+	}{
+		{"a", 1},
+		{"é", 1},
+		{"e\u0301", 1},
+		{"世", 2},
+		{"ｆ", 2},
+		{"👋", 2},
+		{"👩‍🔬", 2},
+		{"❤", 1},
+		{"❤\ufe0f", 2},
+		{"\u200b", 0},
+		{"\x07", 0},
+	}
+
+	for _, test := range tests {
+		if actual := cellWidth(test.input); actual != test.width {
+			t.Errorf(`cellWidth(%#v) = %d; want %d`, test.input, actual, test.width)
+		}
+	}
+}
+
+func TestMeasureDisplayWidth(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"Hello, 世界! 👋 ｆｕｌｌｗｉｄｔｈ", nil,
+			[]string{"Hello, ", "世界! 👋 ", "ｆｕｌｌｗｉ", "ｄｔｈ"}, 12},
+
+		{"クラウンの直接土地を保持している", nil,
+			[]string{"クラウンの", "直接土地を", "保持してい", "る"}, 10},
+
+		{"ok 👋🏽👋🏽 ok", nil,
+			[]string{"ok ", "👋🏽👋🏽 ", "ok"}, 6},
+
+		{"ok 👋🏽👋🏽 ok", []SplitBuilderOption{EmojiWidth(1)},
+			[]string{"ok 👋🏽👋🏽 ", "ok"}, 6},
+
+		{"ab 世界", []SplitBuilderOption{PadLastLine(true)},
+			[]string{"ab ", "世界  "}, 6},
+
+		{"abcde\u0301fg", nil,
+			[]string{"abcde\u0301", "fg"}, 5},
+
+		{"ab e\u0301\u0302 cd", nil,
+			[]string{"ab e\u0301\u0302 ", "cd"}, 5},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{MeasureBy(MeasureDisplayWidth)}, test.options...)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		for _, l := range actual {
+			if w := sb.measure(l); w > test.bytelim {
+				t.Errorf(`SplitString(%#v) line %#v is %d columns wide; want at most %d`, test.input, l, w, test.bytelim)
+			}
+		}
+	}
+}
+
+func TestMeasureRunes(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"Привет, как дела?",
+			[]string{"Привет, ", "как дела?"}, 10},
+
+		{"e\u0301e\u0301e\u0301 e\u0302\u0323e",
+			[]string{"e\u0301e\u0301e\u0301 ", "e\u0302\u0323e"}, 4},
+
+		{"abcde\u0301\u0302\u0303fgh",
+			[]string{"abcde\u0301\u0302\u0303", "fgh"}, 5},
+
+		{"👩\u200d\U0001f52c🇺🇸👋\U0001f3fd ok",
+			[]string{"👩\u200d\U0001f52c🇺🇸👋\U0001f3fd ", "ok"}, 4},
+	}
+
+	sb := NewSplitBuilder(MeasureBy(MeasureRunes))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package wordwrap
+
+import "errors"
+
+// ErrMinLinesExceedsMaxLines is returned when splitting with a MinLines floor
+// above the MaxLines cap, which no output could satisfy.
+var ErrMinLinesExceedsMaxLines = errors.New("wordwrap: MinLines exceeds MaxLines")
+
+// MinLines pads the output with empty lines until it has at least n lines,
+// keeping the height of fixed layouts stable however short the input. The
+// padding lines are decorated as any other line, so BoxBorders frames them
+// and PadLastLine fills the last of them. A floor of 0 disables the padding.
+//
+// MinLines must not exceed MaxLines when both are set, otherwise splitting
+// fails with ErrMinLinesExceedsMaxLines. Padding is only added once the whole
+// input was split without error.
+func MinLines(n uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.minLines = n
+		sb.asIs = n <= 1
+	}
+}
+
+// EmptyInputYieldsLine makes splitting empty input produce a single empty
+// line, for callers which represent a blank field as one line. By default
+// empty input produces no lines, and WrapString returns "" either way.
+func EmptyInputYieldsLine(yield bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.emptyInputLine = yield
+		sb.asIs = true
+	}
+}
+
+// fill pushes empty lines at the end of the input until MinLines is met, or
+// until there is one for empty input per EmptyInputYieldsLine.
+func (sp *splitter) fill() {
+	min := sp.sb.minLines
+	if sp.sb.emptyInputLine && sp.s == "" && min < 1 {
+		min = 1
+	}
+
+	for uint(sp.line) < min && !sp.done {
+		sp.push(line{start: len(sp.s), end: len(sp.s)})
+		sp.line++
+	}
+}
@@ -0,0 +1,82 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMinLines(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"aaa bbb", []SplitBuilderOption{MinLines(4)},
+			[]string{"aaa ", "bbb", "", ""}, nil, 4},
+
+		{"", []SplitBuilderOption{MinLines(2)},
+			[]string{"", ""}, nil, 4},
+
+		{"aaa bbb ccc", []SplitBuilderOption{MinLines(2)},
+			[]string{"aaa ", "bbb ", "ccc"}, nil, 4},
+
+		{"ab", []SplitBuilderOption{MinLines(2), BoxBorders("|", "|"), PadLastLine(true)},
+			[]string{"|ab  |", "|    |"}, nil, 4},
+
+		{"aaa bbb", []SplitBuilderOption{MinLines(3), MaxLines(3)},
+			[]string{"aaa ", "bbb", ""}, nil, 4},
+
+		{"aaa bbb", []SplitBuilderOption{MinLines(3), MaxLines(2)},
+			nil, ErrMinLinesExceedsMaxLines, 4},
+
+		{"aaa bbb", []SplitBuilderOption{MinLines(3), Lossless(true)},
+			[]string{"aaa ", "bbb"}, nil, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if test.output != nil && !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestEmptyInputYieldsLine(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+	}{
+		{"", nil,
+			[]string{}},
+
+		{"", []SplitBuilderOption{EmptyInputYieldsLine(true)},
+			[]string{""}},
+
+		{"", []SplitBuilderOption{EmptyInputYieldsLine(true), PadLastLine(true)},
+			[]string{"    "}},
+
+		{"", []SplitBuilderOption{EmptyInputYieldsLine(true), MinLines(2)},
+			[]string{"", ""}},
+
+		{"ab", []SplitBuilderOption{EmptyInputYieldsLine(true)},
+			[]string{"ab"}},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, 4)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
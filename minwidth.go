@@ -0,0 +1,56 @@
+package wordwrap
+
+import "errors"
+
+// ErrNoWidthFits is returned by MinWidthForLines when s can't be wrapped into
+// the lines at any width, as when its hard breaks alone produce more lines.
+var ErrNoWidthFits = errors.New("wordwrap: no width fits the text in the lines")
+
+// minWidthDoublings is the number of times MinWidthForLines doubles the width
+// of the text looking for a width at which it fits.
+const minWidthDoublings = 16
+
+// MinWidthForLines returns the narrowest width at which s wraps into at most
+// maxLines lines, for sizing a box to its text.
+//
+// The width is searched for by bisection between 1 and one more than the
+// width of s in the active WidthMode, at which s fits on a single line unless
+// it holds hard breaks or the options narrow its lines, as prefixes and
+// reserved columns do. While s doesn't fit, the widest width is doubled, up to
+// 16 times. Widths at which wrapping fails, such as those too narrow for a
+// character, don't fit. If s doesn't fit at the widest width, the error
+// wrapping it there is returned, or ErrNoWidthFits.
+func (sb *SplitBuilder) MinWidthForLines(s string, maxLines uint) (uint, error) {
+	hi := sb.measure(s) + 1
+	ok, err := sb.FitsInBox(s, hi, maxLines)
+	for i := 0; !ok && i < minWidthDoublings; i++ {
+		hi *= 2
+		ok, err = sb.FitsInBox(s, hi, maxLines)
+	}
+
+	if !ok {
+		if err == nil {
+			err = ErrNoWidthFits
+		}
+
+		return 0, err
+	}
+
+	lo := uint(1)
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if ok, _ := sb.FitsInBox(s, mid, maxLines); ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return lo, nil
+}
+
+// MinWidthForLines returns the narrowest byte limit at which s wraps into at
+// most maxLines lines.
+func MinWidthForLines(s string, maxLines uint) (uint, error) {
+	return DefaultSplitBuilder.MinWidthForLines(s, maxLines)
+}
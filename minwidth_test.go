@@ -0,0 +1,48 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestSplitBuilder_MinWidthForLines(t *testing.T) {
+	tests := []struct {
+		input    string
+		options  []SplitBuilderOption
+		maxLines uint
+		output   uint
+		err      error
+	}{
+		{"the quick brown fox", nil, 1, 20, nil},
+		{"the quick brown fox", nil, 2, 10, nil},
+		{"the quick brown fox", nil, 4, 6, nil},
+		{"the quick brown fox", nil, 19, 1, nil},
+		{"aaaa", nil, 2, 2, nil},
+		{"し", nil, 1, 3, nil},
+		{"a\nb\nc", []SplitBuilderOption{OnlyReflowOverLong(true)}, 2, 0, ErrNoWidthFits},
+		{"ab", nil, 0, 0, ErrNoWidthFits},
+		{"abcdef", []SplitBuilderOption{LinePrefix("> ")}, 1, 8, nil},
+		{"the quick brown fox", []SplitBuilderOption{LinePrefix("> ")}, 2, 12, nil},
+		{"abcdef", []SplitBuilderOption{ReserveTrailing(30)}, 1, 36, nil},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(test.options...)
+		actual, err := sb.MinWidthForLines(test.input, test.maxLines)
+		if actual != test.output || err != test.err {
+			t.Errorf(`MinWidthForLines(%#v, %d) = %d, %v; want %d, %v`, test.input, test.maxLines, actual, err, test.output, test.err)
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if ok, _ := sb.FitsInBox(test.input, actual, test.maxLines); !ok {
+			t.Errorf(`MinWidthForLines(%#v, %d) = %d which doesn't fit`, test.input, test.maxLines, actual)
+		}
+
+		if ok, _ := sb.FitsInBox(test.input, actual-1, test.maxLines); actual > 1 && ok {
+			t.Errorf(`MinWidthForLines(%#v, %d) = %d but %d fits`, test.input, test.maxLines, actual, actual-1)
+		}
+	}
+}
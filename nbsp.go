@@ -0,0 +1,38 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// HonorNonBreakingSpace keeps the text on either side of a non-breaking space
+// U+00A0, figure space U+2007, narrow non-breaking space U+202F, word joiner
+// U+2060 or zero-width no-break space U+FEFF together, as in "10\u00a0kg" or
+// "Mr.\u00a0Smith", rather than breaking at the space as whitespace. The line
+// breaks at an earlier opportunity instead. This takes precedence over
+// WhitespaceFunc, BreakPriorities and UseUAX14.
+//
+// As with other options keeping text together, a run too long for a line is
+// still broken per the BreakStrategy.
+func HonorNonBreakingSpace(honor bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.honorNBSP = honor
+		sb.asIs = true
+	}
+}
+
+// nonBreakingAt reports whether the rune r, or the rune following it at byte
+// offset next of s, is a non-breaking space, such that no break may fall
+// between them.
+func (sb *SplitBuilder) nonBreakingAt(s string, next int, r rune) bool {
+	following, _ := utf8.DecodeRuneInString(s[next:])
+	return isNonBreaking(r) || isNonBreaking(following)
+}
+
+// isNonBreaking reports whether r is a space or joiner which must not be
+// broken at.
+func isNonBreaking(r rune) bool {
+	switch r {
+	case '\u00a0', '\u2007', '\u202f', '\u2060', '\ufeff':
+		return true
+	}
+
+	return false
+}
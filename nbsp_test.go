@@ -0,0 +1,86 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHonorNonBreakingSpace(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"said Mr.\u00a0Smith today", []SplitBuilderOption{HonorNonBreakingSpace(true)},
+			[]string{"said ", "Mr.\u00a0Smith ", "today"}, 12},
+
+		{"said Mr.\u00a0Smith today", nil,
+			[]string{"said Mr.\u00a0", "Smith today"}, 12},
+
+		{"it weighs 10\u202fkg", []SplitBuilderOption{HonorNonBreakingSpace(true)},
+			[]string{"it weighs ", "10\u202fkg"}, 14},
+
+		{"漢字\u2060漢字", []SplitBuilderOption{HonorNonBreakingSpace(true), UseUAX14(true)},
+			[]string{"漢字\u2060漢", "字"}, 12},
+
+		{"a\u00a0b c", []SplitBuilderOption{HonorNonBreakingSpace(true), WhitespaceFunc(func(r rune) bool { return r == ' ' || r == '\u00a0' })},
+			[]string{"a\u00a0b ", "c"}, 5},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestHonorNonBreakingSpace_neverSplits(t *testing.T) {
+	const input = "and then Mr.\u00a0Smith said hello to Mrs.\u00a0Smith again"
+
+	sb := NewSplitBuilder(HonorNonBreakingSpace(true))
+	for lim := uint(12); lim < 40; lim++ {
+		lines, err := sb.SplitString(input, lim)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, l := range lines {
+			if l == "Mr.\u00a0" || l == "Mrs.\u00a0" {
+				t.Errorf(`SplitString(%#v, %d) = %#v; want the tokens around the NBSP together`, input, lim, lines)
+			}
+		}
+	}
+}
+
+func TestHonorNonBreakingSpace_invalidUTF8(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"ab \xff", nil,
+			[]string{"ab �"}, 10},
+
+		{"ab \xff cd ef", []SplitBuilderOption{InvalidUTF8Policy(InvalidUTF8Keep)},
+			[]string{"ab \xff ", "cd ef"}, 8},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append(test.options, HonorNonBreakingSpace(true))...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
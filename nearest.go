@@ -0,0 +1,42 @@
+package wordwrap
+
+// BreakNearest lets a line exceed its limit by up to tolerance when doing so
+// lands on a break opportunity nearer to the limit than the last one which
+// fits, producing more uniform line widths.
+//
+// Lines produced with a tolerance may be longer than the limit, by at most the
+// tolerance. On a tie the break which fits is preferred.
+func BreakNearest(tolerance uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.breakNearest = tolerance
+		sb.asIs = true
+	}
+}
+
+// nearestBreak returns the break opportunity of the working line nearest to
+// limit, choosing between brk, the last which fits at brkWidth, and the first
+// which exceeds the limit within the tolerance. The end of the input counts
+// as an opportunity.
+func (sp *splitter) nearestBreak(limit uint, brk int, brkWidth uint) int {
+	max := limit + sp.sb.breakNearest
+
+	var w uint
+	for i, c := range sp.chars {
+		w += c.width
+		if w > max {
+			break
+		}
+
+		if w <= limit || !c.brk && c.end() != len(sp.s) {
+			continue
+		}
+
+		if brk == 0 || w-limit < limit-brkWidth {
+			return i + 1
+		}
+
+		break
+	}
+
+	return brk
+}
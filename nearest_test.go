@@ -0,0 +1,50 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakNearest(t *testing.T) {
+	tests := []struct {
+		input     string
+		output    []string
+		tolerance uint
+		bytelim   uint
+	}{
+		{"the quick brown fox",
+			[]string{"the quick ", "brown fox"}, 0, 12},
+
+		{"one twothree four",
+			[]string{"one ", "twothree ", "four"}, 0, 10},
+
+		{"one twothree four",
+			[]string{"one twothree ", "four"}, 3, 10},
+
+		{"one twothree four",
+			[]string{"one ", "twothree ", "four"}, 2, 10},
+
+		{"abcdefg hij",
+			[]string{"abcdefg hij"}, 2, 10},
+
+		{"abcdefg hijklmn",
+			[]string{"abcdefg ", "hijklmn"}, 4, 10},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(BreakNearest(test.tolerance)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		for _, line := range actual {
+			if uint(len(line)) > test.bytelim+test.tolerance {
+				t.Errorf(`SplitString(%#v) line %#v exceeds %d bytes`, test.input, line, test.bytelim+test.tolerance)
+			}
+		}
+	}
+}
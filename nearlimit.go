@@ -0,0 +1,23 @@
+package wordwrap
+
+// NearLimitCallback calls fn with the index and width of every produced line
+// whose width is within threshold of its limit, such as lines which might
+// overflow after minor edits, for tooling and CI checks on text files.
+//
+// The width is measured in the active WidthMode after trimming but before any
+// padding or borders. The callback is informational only and does not change
+// the output.
+func NearLimitCallback(threshold uint, fn func(lineIndex int, width uint)) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.nearLimitThreshold = threshold
+		sb.nearLimit = fn
+		sb.asIs = fn == nil
+	}
+}
+
+func (sb *SplitBuilder) reportNearLimit(l line, limit uint) {
+	w := sb.measure(l.text)
+	if w+sb.nearLimitThreshold >= limit {
+		sb.nearLimit(l.index, w)
+	}
+}
@@ -0,0 +1,57 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNearLimitCallback(t *testing.T) {
+	type report struct {
+		index int
+		width uint
+	}
+
+	tests := []struct {
+		input     string
+		threshold uint
+		options   []SplitBuilderOption
+		reports   []report
+		bytelim   uint
+	}{
+		{"aaaa bb cccccc d", 1, nil,
+			[]report{{0, 8}, {1, 7}}, 8},
+
+		{"aaaa bb cccccc d", 0, nil,
+			[]report{{0, 8}}, 8},
+
+		{"aaaa bb cccccc d", 1, []SplitBuilderOption{TrimTrailingWhiteSpace(true)},
+			[]report{{2, 6}}, 7},
+
+		{"ab", 1, nil,
+			[]report{{0, 2}}, 3},
+
+		{"aaa bbb", 0, []SplitBuilderOption{PadLastLine(true)},
+			[]report{{0, 4}}, 4},
+	}
+
+	for _, test := range tests {
+		reports := []report{}
+		cb := NearLimitCallback(test.threshold, func(lineIndex int, width uint) {
+			reports = append(reports, report{lineIndex, width})
+		})
+
+		want, _ := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		actual, err := NewSplitBuilder(append(test.options, cb)...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, want)
+		}
+
+		if !reflect.DeepEqual(reports, test.reports) {
+			t.Errorf(`SplitString(%#v) reported %#v; want %#v`, test.input, reports, test.reports)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package wordwrap
+
+// PreserveNewlines keeps the newlines of the input as line breaks, such as
+// those between user-entered paragraphs, rather than reflowing across them as
+// whitespace. The text between newlines is wrapped independently.
+//
+// Newlines are dropped from the output along with a carriage return preceding
+// them, so "\r\n" breaks as "\n" does. An empty input line, as between two
+// paragraphs, produces an empty line. Lines ending at a newline are hard
+// breaks, followed by the LineSeparator even when a SoftBreakSeparator is
+// set.
+func PreserveNewlines(preserve bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.preserveNewlines = preserve
+		sb.asIs = true
+	}
+}
+
+// preserveNewline breaks at the newline at byte offset i when the working
+// line is empty. The newline ends the line queued before it if that line was
+// broken by wrapping, and otherwise ends an empty input line.
+func (sp *splitter) preserveNewline(i int) {
+	if sp.queued && !sp.pending.hard {
+		sp.pending.newline, sp.pending.hard = true, true
+		return
+	}
+
+	sp.push(line{text: "", start: i, end: i, mdOpen: sp.mdOpen, prefix: sp.prefix, newline: true, hard: true})
+	sp.line++
+}
@@ -0,0 +1,86 @@
+package wordwrap
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPreserveNewlines(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox\njumps over\nthe lazy dog",
+			[]string{"the quick ", "brown fox", "jumps ", "over", "the lazy ", "dog"}, 10},
+
+		{"first paragraph here\n\nsecond one\n\nthird",
+			[]string{"first ", "paragraph ", "here", "", "second one", "", "third"}, 12},
+
+		{"ab\r\ncd\r\n\r\nef",
+			[]string{"ab", "cd", "", "ef"}, 10},
+
+		{"\nab\n",
+			[]string{"", "ab"}, 10},
+
+		{"abcd\nef",
+			[]string{"abcd", "ef"}, 4},
+
+		{"abcd\n\nef",
+			[]string{"abcd", "", "ef"}, 4},
+
+		{"no newlines at all",
+			[]string{"no newlines at all"}, 20},
+	}
+
+	sb := NewSplitBuilder(PreserveNewlines(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		scanner := bufio.NewScanner(strings.NewReader(test.input))
+		scanner.Split(ScanWrappedLines(test.bytelim, PreserveNewlines(true)))
+		scanned := []string{}
+		for scanner.Scan() {
+			scanned = append(scanned, scanner.Text())
+		}
+
+		if !reflect.DeepEqual(scanned, test.output) {
+			t.Errorf(`Scan(%#v) = %#v; want %#v`, test.input, scanned, test.output)
+		}
+	}
+}
+
+func TestPreserveNewlines_paragraphs(t *testing.T) {
+	paragraphs := []string{
+		"If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die,",
+		"and at his death his heir shall be of full age and owe a 'relief',",
+		"the heir shall have his inheritance on payment of the ancient scale of 'relief'.",
+	}
+
+	sb := NewSplitBuilder(PreserveNewlines(true), SoftBreakSeparator(" "))
+	wrapped, err := sb.Wrap(strings.Join(paragraphs, "\n"), 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := strings.Split(wrapped, "\n")
+	if len(actual) != len(paragraphs) {
+		t.Fatalf(`Wrap = %#v; want %d paragraphs`, wrapped, len(paragraphs))
+	}
+
+	for i, p := range paragraphs {
+		lines, _ := sb.SplitString(p, 30)
+		if want := strings.Join(lines, " "); actual[i] != want {
+			t.Errorf(`Wrap paragraph %d = %#v; want %#v`, i, actual[i], want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package wordwrap
+
+// NextLine returns the first wrapped line of s and the rest of s left to wrap,
+// so lines can be pulled one at a time:
+//
+//	for s != "" {
+//		line, s, err = sb.NextLine(s, 80)
+//		...
+//	}
+//
+// rest is a sub-slice of s starting where the next line does, and is empty
+// once line is the last line of s. Each call treats s as a fresh input, so
+// FirstLineLimit and Markdown spans left open are not carried from one call to
+// the next.
+//
+// An error is only returned when no line could be produced before it, in
+// which case line and rest are empty. Splitting s stops after the first line,
+// so an error further into s is returned by the call reaching it.
+func (sb *SplitBuilder) NextLine(s string, byteLimit uint) (string, string, error) {
+	var (
+		first line
+		found bool
+		next  = len(s)
+		sp    *splitter
+	)
+
+	sp = sb.newSplitter(s, byteLimit, func(l line) bool {
+		first, found = l, true
+		if sp.queued {
+			next = sp.pending.start
+		} else if len(sp.chars) > 0 {
+			next = sp.chars[0].pos
+		}
+
+		return false
+	})
+	sp.streaming = true
+
+	err := sp.run()
+	if !found {
+		return "", "", err
+	}
+
+	return first.text, s[next:], nil
+}
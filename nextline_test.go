@@ -0,0 +1,62 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_NextLine(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", nil, 4},
+		{"the quick brown fox jumps over the lazy dog", nil, 10},
+		{"family 👨‍👩‍👧 and scientist 👩‍🔬 emoji", nil, 20},
+		{"roses are **red**  \nviolets are _blue_", []SplitBuilderOption{MarkdownHardBreaks(true)}, 14},
+		{"short", []SplitBuilderOption{PadLastLine(true)}, 8},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(test.options...)
+		want, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := []string{}
+		for s := test.input; s != ""; {
+			var line, rest string
+			line, rest, err = sb.NextLine(s, test.bytelim)
+			if err != nil {
+				t.Fatalf(`NextLine(%#v) unexpected error: %s`, s, err)
+			}
+
+			if len(rest) >= len(s) || rest != s[len(s)-len(rest):] {
+				t.Fatalf(`NextLine(%#v) rest = %#v; want a shorter suffix`, s, rest)
+			}
+
+			actual = append(actual, line)
+			s = rest
+		}
+
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf(`NextLine(%#v) = %#v; want %#v`, test.input, actual, want)
+		}
+	}
+}
+
+func TestSplitBuilder_NextLine_error(t *testing.T) {
+	sb := NewSplitBuilder()
+
+	line, rest, err := sb.NextLine("ab し", 2)
+	if line != "ab" || rest != " し" || err != nil {
+		t.Errorf(`NextLine = %#v, %#v, %v; want "ab", " し", <nil>`, line, rest, err)
+	}
+
+	line, rest, err = sb.NextLine("し", 2)
+	if line != "" || rest != "" || err != ErrCharacterTooLarge {
+		t.Errorf(`NextLine = %#v, %#v, %v; want "", "", %v`, line, rest, err, ErrCharacterTooLarge)
+	}
+}
@@ -0,0 +1,84 @@
+package wordwrap
+
+// Action is what to do with a character too large to fit on a line, as
+// decided by an OversizeHandlerFunc.
+type Action int
+
+const (
+	// ActionEmit places the character, or its replacement if one is given,
+	// as usual. If it still doesn't fit, along with the closers of any
+	// Markdown spans open after it, it is emitted alone on a line which
+	// exceeds the limit.
+	ActionEmit Action = iota
+	// ActionSkip drops the character from the output.
+	ActionSkip
+	// ActionBreak breaks a character of runes joined by zero-width joiners
+	// into as few pieces as fit, dropping the joiners at the breaks. A
+	// single rune cannot be broken and results in ErrCharacterTooLarge.
+	ActionBreak
+	// ActionAbort stops splitting with ErrCharacterTooLarge.
+	ActionAbort
+)
+
+// OversizeHandlerFunc decides what to do with a character which is larger than
+// the limit of the line it falls on. It may return a replacement for the
+// character, which is only used with ActionEmit.
+type OversizeHandlerFunc func(cluster string, limit uint) (replacement string, action Action)
+
+// OversizeHandler sets a function consulted for each character too large to
+// fit on a line, in place of failing with ErrCharacterTooLarge.
+//
+// The function runs inline during splitting, once per occurrence, and may log,
+// substitute, skip or abort. A nil function restores the default behavior.
+func OversizeHandler(fn OversizeHandlerFunc) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.oversizeHandler = fn
+		sb.asIs = true
+	}
+}
+
+// handleOversize resolves the first character of the working line, which does
+// not fit on the line alone, with the oversize handler.
+func (sp *splitter) handleOversize() error {
+	c := sp.chars[0]
+	replacement, action := sp.sb.oversizeHandler(sp.text(sp.chars[:1]), sp.limit())
+
+	switch action {
+	case ActionEmit:
+		if replacement == "" {
+			sp.emit(1)
+			return nil
+		}
+
+		sp.width -= c.width
+		c.text, c.replaced, c.width = replacement, true, sp.sb.measure(replacement)
+		sp.width += c.width
+		sp.chars[0] = c
+
+		if !sp.fitsAlone(c) {
+			sp.emit(1)
+		}
+	case ActionSkip:
+		sp.width -= c.width
+		sp.chars = sp.chars[:copy(sp.chars, sp.chars[1:])]
+	case ActionBreak:
+		if !sp.breakSequence() {
+			return ErrCharacterTooLarge
+		}
+	default:
+		return ErrCharacterTooLarge
+	}
+
+	return nil
+}
+
+// fitsAlone reports whether the character c fits on the working line alone,
+// along with the closers of the Markdown spans open after it.
+func (sp *splitter) fitsAlone(c charPos) bool {
+	w := c.width
+	if sp.sb.markdownInlineAware {
+		w += uint(len(mdClosers(mdToggle(sp.mdOpen, sp.s, c.pos))))
+	}
+
+	return w <= sp.limit()
+}
@@ -0,0 +1,76 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOversizeHandler(t *testing.T) {
+	tests := []struct {
+		input       string
+		replacement string
+		action      Action
+		output      []string
+		err         error
+	}{
+		{"ab し cd", "?", ActionEmit, []string{"ab", " ", "? ", "cd"}, nil},
+		{"ab し cd", "", ActionEmit, []string{"ab", " ", "し", " ", "cd"}, nil},
+		{"ab し cd", "", ActionSkip, []string{"ab", " ", " ", "cd"}, nil},
+		{"ab し cd", "", ActionBreak, []string{"ab", " "}, ErrCharacterTooLarge},
+		{"ab し cd", "", ActionAbort, []string{"ab", " "}, ErrCharacterTooLarge},
+	}
+
+	for _, test := range tests {
+		var seen []string
+		sb := NewSplitBuilder(OversizeHandler(func(cluster string, limit uint) (string, Action) {
+			seen = append(seen, cluster)
+			if limit != 2 {
+				t.Errorf(`handler limit = %d; want 2`, limit)
+			}
+
+			return test.replacement, test.action
+		}))
+
+		actual, err := sb.SplitString(test.input, 2)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		if !reflect.DeepEqual(seen, []string{"し"}) {
+			t.Errorf(`handler called with %#v; want %#v`, seen, []string{"し"})
+		}
+	}
+}
+
+func TestOversizeHandler_markdownClosers(t *testing.T) {
+	tests := []struct {
+		input     string
+		byteLimit uint
+		output    []string
+	}{
+		{"`", 1, []string{"?"}},
+		{"*a*", 2, []string{"**", "*?*", "**"}},
+		{"**ab** cd", 1, []string{"?**", "**?**", "**?**", "**?**", "**?", "*", " ", "c", "d"}},
+		{"**ab** cd", 2, []string{"?**", "**?**", "**?**", "**?**", "**?", "* ", "cd"}},
+		{"**ab** cd", 3, []string{"***", "**?**", "**?**", "**?**", "***", "* ", "cd"}},
+	}
+
+	sb := NewSplitBuilder(MarkdownInlineAware(true), OversizeHandler(func(cluster string, limit uint) (string, Action) {
+		return "?", ActionEmit
+	}))
+
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.byteLimit)
+		if err != nil {
+			t.Errorf(`SplitString(%#v, %d) error = %v; want nil`, test.input, test.byteLimit, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v, %d) = %#v; want %#v`, test.input, test.byteLimit, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package wordwrap
+
+// PadLastLine pads the final line with trailing spaces up to the limit of its
+// line, such that fixed-width records end on a full line. Inter-word spacing
+// is left untouched. A final line already at or over its limit is unchanged.
+func PadLastLine(pad bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.padLastLine = pad
+		sb.asIs = !pad
+	}
+}
+
+// padRight pads line with trailing spaces to width in the active WidthMode.
+func (sb *SplitBuilder) padRight(line string, width uint) string {
+	w := sb.measure(line)
+	if w >= width {
+		return line
+	}
+
+	b := make([]byte, len(line)+int(width-w))
+	n := copy(b, line)
+	for i := n; i < len(b); i++ {
+		b[i] = ' '
+	}
+
+	return string(b)
+}
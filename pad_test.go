@@ -0,0 +1,41 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPadLastLine(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox",
+			[]string{"the quick ", "brown fox "}, 10},
+
+		{"the quick",
+			[]string{"the quick   "}, 12},
+
+		{"abcdefgh",
+			[]string{"abcd", "efgh"}, 4},
+
+		{"",
+			[]string{}, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(PadLastLine(true)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		if n := len(actual); n > 0 && uint(len(actual[n-1])) != test.bytelim {
+			t.Errorf(`SplitString(%#v) last line %#v is not %d bytes`, test.input, actual[n-1], test.bytelim)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package wordwrap
+
+// ErrorReturnsPartial sets whether splitting which fails returns the lines
+// produced before the failure along with the error, as it does by default.
+// When false, a failed split returns the error alone, with no lines, so a
+// caller never mistakes a partial result for a complete one.
+//
+// Lines are then held back until the whole input is split, so early exits
+// such as that of FitsInBox only happen once it completes. NearLimitCallback
+// is still called as lines are produced.
+// ScanWrappedLines and NextLine, which return lines as soon as they are
+// known, are unaffected.
+func ErrorReturnsPartial(partial bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.errorDropsPartial = !partial
+		sb.asIs = true
+	}
+}
+
+// holds reports whether lines are held back until the split completes.
+func (sp *splitter) holds() bool {
+	return sp.sb.errorDropsPartial && !sp.streaming
+}
@@ -0,0 +1,55 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestErrorReturnsPartial(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"ab し", nil,
+			[]string{"ab", " "}, ErrCharacterTooLarge, 2},
+
+		{"ab し", []SplitBuilderOption{ErrorReturnsPartial(true)},
+			[]string{"ab", " "}, ErrCharacterTooLarge, 2},
+
+		{"ab し", []SplitBuilderOption{ErrorReturnsPartial(false)},
+			[]string{}, ErrCharacterTooLarge, 2},
+
+		{"ab cdefgh", []SplitBuilderOption{ErrorReturnsPartial(false), BreakStrategy([]Strategy{ReturnError})},
+			[]string{}, ErrWordTooLarge, 4},
+
+		{"ab cd ef", []SplitBuilderOption{ErrorReturnsPartial(false)},
+			[]string{"ab ", "cd ", "ef"}, nil, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestErrorReturnsPartial_stream(t *testing.T) {
+	sb := NewSplitBuilder(ErrorReturnsPartial(false))
+
+	line, rest, err := sb.NextLine("ab し", 2)
+	if line != "ab" || rest != " し" || err != nil {
+		t.Errorf(`NextLine = %#v, %#v, %v; want "ab", " し", <nil>`, line, rest, err)
+	}
+
+	if ok, err := sb.FitsInBox("aa bb cc", 3, 2); ok || err != nil {
+		t.Errorf(`FitsInBox = %t, %v; want false, <nil>`, ok, err)
+	}
+}
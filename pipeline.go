@@ -0,0 +1,38 @@
+package wordwrap
+
+// Stage is a step of a Pipeline, transforming its input string.
+type Stage func(s string) (string, error)
+
+// Pipeline runs a string through a sequence of stages, each given the output
+// of the one before, as for multi-pass processing which normalizes, wraps to
+// paragraphs and then re-wraps with other options.
+type Pipeline struct {
+	stages []Stage
+}
+
+// NewPipeline creates a Pipeline running the given stages in order.
+func NewPipeline(stages ...Stage) *Pipeline {
+	return &Pipeline{stages: stages}
+}
+
+// Run passes s through every stage in order and returns the output of the
+// last. The first stage to fail stops the pipeline, and its output is
+// returned along with its error.
+func (p *Pipeline) Run(s string) (string, error) {
+	for _, st := range p.stages {
+		var err error
+		if s, err = st(s); err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+// WrapStage returns a Stage wrapping its input with sb as WrapAndSplit does.
+func WrapStage(sb *SplitBuilder, byteLimit uint) Stage {
+	return func(s string) (string, error) {
+		_, wrapped, err := sb.WrapAndSplit(s, byteLimit)
+		return wrapped, err
+	}
+}
@@ -0,0 +1,46 @@
+package wordwrap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	errStage := errors.New("stage failed")
+	upper := func(s string) (string, error) {
+		return strings.ToUpper(s), nil
+	}
+	fail := func(s string) (string, error) {
+		return "partial", errStage
+	}
+
+	tests := []struct {
+		input  string
+		stages []Stage
+		output string
+		err    error
+	}{
+		{"the quick brown fox", nil,
+			"the quick brown fox", nil},
+
+		{"the quick brown fox", []Stage{upper, WrapStage(NewSplitBuilder(), 10)},
+			"THE QUICK \nBROWN FOX", nil},
+
+		{"the quick brown fox", []Stage{WrapStage(NewSplitBuilder(TrimTrailingWhiteSpace(true)), 10), WrapStage(NewSplitBuilder(PadLastLine(true)), 20)},
+			"the quick\nbrown fox ", nil},
+
+		{"the quick brown fox", []Stage{fail, upper},
+			"partial", errStage},
+
+		{"ab し", []Stage{WrapStage(NewSplitBuilder(), 2), upper},
+			"ab\n ", ErrCharacterTooLarge},
+	}
+
+	for _, test := range tests {
+		actual, err := NewPipeline(test.stages...).Run(test.input)
+		if actual != test.output || err != test.err {
+			t.Errorf(`Run(%#v) = %#v, %v; want %#v, %v`, test.input, actual, err, test.output, test.err)
+		}
+	}
+}
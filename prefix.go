@@ -0,0 +1,179 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// LinePrefix prepends prefix to every line, such as the "// " of a comment
+// block or the "> " of quoted text. The width of the prefix counts toward the
+// limit of each line, so lines along with their prefix still fit it. Empty
+// lines hold only the prefix less its trailing whitespace.
+//
+// Along with DetectLinePrefix, prefix is placed before the prefix detected on
+// each line.
+func LinePrefix(prefix string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.firstPrefix, sb.prefix = prefix, prefix
+		sb.asIs = prefix == ""
+	}
+}
+
+// FirstLinePrefix sets the prefix of the first line, as the bullet of a list
+// item, overriding LinePrefix for it. Its width counts toward the limit of
+// the first line as with LinePrefix.
+func FirstLinePrefix(prefix string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.firstPrefix = prefix
+		sb.asIs = prefix == ""
+	}
+}
+
+// ContinuationPrefix sets the prefix of every line after the first, as the
+// hanging indent of a list item continued onto further lines, overriding
+// LinePrefix for them. Its width counts toward the limit of each of them as
+// with LinePrefix.
+//
+//	NewSplitBuilder(FirstLinePrefix("- "), ContinuationPrefix("  "))
+func ContinuationPrefix(prefix string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.prefix = prefix
+		sb.asIs = true
+	}
+}
+
+// prefixFor returns the prefix set for the line at lineIndex.
+func (sb *SplitBuilder) prefixFor(lineIndex int) string {
+	if lineIndex == 0 {
+		return sb.firstPrefix
+	}
+
+	return sb.prefix
+}
+
+// linePrefixOf returns the prefix prepended to l, that set for it followed by
+// that detected per DetectLinePrefix.
+func (sb *SplitBuilder) linePrefixOf(l line) string {
+	return sb.prefixFor(l.index) + l.prefix
+}
+
+// DetectLinePrefix reflows text whose lines carry a prefix, such as the "// "
+// or "# " of code comments. The prefix of each input line, along with any
+// indentation before it, is removed before wrapping and prepended to every
+// line wrapped from it, reducing the limit of the line by its width.
+//
+// The prefix of a line is the first of prefixes it starts with after its
+// indentation, or the prefix less its trailing whitespace if that is all the
+// line holds. Consecutive lines with the same prefix are reflowed together,
+// while a change of prefix or a line blank past its prefix ends the
+// paragraph, so lines without any of the prefixes are reflowed apart from
+// those with one. Blank lines are kept, holding only their prefix less its
+// trailing whitespace.
+//
+// Newlines of the input are dropped, or replaced by a space where lines are
+// joined. OnlyReflowOverLong and Markdown hard breaks are not supported along
+// with it.
+func DetectLinePrefix(prefixes ...string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.linePrefixes = prefixes
+	}
+}
+
+// longestPrefix returns the length in bytes of the longest prefix detected
+// per DetectLinePrefix.
+func (sb *SplitBuilder) longestPrefix() int {
+	n := 0
+	for _, p := range sb.linePrefixes {
+		if len(p) > n {
+			n = len(p)
+		}
+	}
+
+	return n
+}
+
+// linePrefix returns the prefix of the input line starting at byte offset i of
+// s along with its indentation, and whether the line is blank past it.
+func (sb *SplitBuilder) linePrefix(s string, i int) (string, bool) {
+	end := i
+	for end < len(s) && s[end] != '\n' {
+		end++
+	}
+
+	l := s[i:end]
+	indent := 0
+	for indent < len(l) && (l[indent] == ' ' || l[indent] == '\t') {
+		indent++
+	}
+
+	prefix := l[:indent]
+	for _, p := range sb.linePrefixes {
+		rest := l[indent:]
+		if len(rest) >= len(p) && rest[:len(p)] == p {
+			prefix = l[:indent+len(p)]
+			break
+		}
+
+		if t := sb.trimLine(line{text: p}).text; t != "" && sb.trimLine(line{text: rest}).text == t {
+			prefix = l[:indent+len(t)]
+			break
+		}
+	}
+
+	blank := sb.trimLine(line{text: l[len(prefix):]}).text == ""
+
+	return prefix, blank
+}
+
+// stripPrefix skips the prefix of the input line starting at byte offset i,
+// returning the offset of its content. A blank line is emitted at once and
+// skipped whole.
+func (sp *splitter) stripPrefix(i int) (int, bool) {
+	prefix, blank := sp.sb.linePrefix(sp.s, i)
+	sp.prefix = prefix
+
+	if !blank {
+		return i + len(prefix), len(prefix) > 0
+	}
+
+	next := i
+	for next < len(sp.s) && sp.s[next] != '\n' {
+		next++
+	}
+	if next < len(sp.s) {
+		next++
+	}
+
+	sp.push(line{start: i, end: i, prefix: prefix, newline: true, hard: true})
+	sp.line++
+
+	return next, true
+}
+
+// joinsNextLine reports whether the newline at byte offset i joins the line it
+// ends with the next, which is only so when the next line has the same prefix
+// and isn't blank.
+func (sp *splitter) joinsNextLine(i int) bool {
+	if i+1 >= len(sp.s) {
+		return false
+	}
+
+	prefix, blank := sp.sb.linePrefix(sp.s, i+1)
+	return prefix == sp.prefix && !blank
+}
+
+// prefixLine prepends prefix to text, less the trailing whitespace of prefix
+// when text is empty.
+func (sb *SplitBuilder) prefixLine(prefix, text string) string {
+	if text != "" {
+		return prefix + text
+	}
+
+	end := len(prefix)
+	for end > 0 {
+		r, size := utf8.DecodeLastRuneInString(prefix[:end])
+		if !sb.isSpace(r) {
+			break
+		}
+		end -= size
+	}
+
+	return prefix[:end]
+}
@@ -0,0 +1,131 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDetectLinePrefix(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"// the quick brown\n// fox jumps over the lazy dog", nil,
+			[]string{"// the quick ", "// brown fox ", "// jumps over ", "// the lazy dog"}, 16},
+
+		{"\t// the quick brown fox\n\t//\n\t// jumps", nil,
+			[]string{"\t// the quick ", "\t// brown fox", "\t//", "\t// jumps"}, 16},
+
+		{"# one two\n// three four", nil,
+			[]string{"# one two", "// three four"}, 16},
+
+		{"// one two\nplain text here\n// three", nil,
+			[]string{"// one two", "plain text here", "// three"}, 16},
+
+		{"// aa bb cc", []SplitBuilderOption{PadLastLine(true)},
+			[]string{"// aa ", "// bb ", "// cc "}, 6},
+
+		{"// aa bb\n", []SplitBuilderOption{TrimTrailingWhiteSpace(true)},
+			[]string{"// aa", "// bb"}, 6},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{DetectLinePrefix("// ", "# ")}, test.options...)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestLinePrefix(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{LinePrefix("> ")},
+			[]string{"> the quick ", "> brown fox ", "> jumps over ", "> the lazy ", "> dog"}, 13},
+
+		{"short", []SplitBuilderOption{LinePrefix("// ")},
+			[]string{"// short"}, 20},
+
+		{"ab\n\ncd", []SplitBuilderOption{LinePrefix("> "), PreserveNewlines(true)},
+			[]string{"> ab", ">", "> cd"}, 10},
+
+		{"// one two\n// three", []SplitBuilderOption{LinePrefix("> "), DetectLinePrefix("// ")},
+			[]string{"> // one ", "> // two ", "> // three"}, 10},
+
+		{"the quick brown fox", []SplitBuilderOption{LinePrefix("> "), PadLastLine(true), TrimTrailingWhiteSpace(true)},
+			[]string{"> the", "> quick", "> brown", "> fox   "}, 8},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestLinePrefix_fitsLimit(t *testing.T) {
+	text := `If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die, and at his death his heir shall be of full age and owe a 'relief', the heir shall have his inheritance on payment of the ancient scale of 'relief'.`
+
+	wrapped, err := NewSplitBuilder(LinePrefix("> ")).Wrap(text, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, l := range strings.Split(wrapped, "\n") {
+		if !strings.HasPrefix(l, "> ") || len(l) > 80 {
+			t.Errorf(`Wrap line %#v; want a "> " prefix and at most 80 bytes`, l)
+		}
+	}
+}
+
+func TestFirstLinePrefix(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{FirstLinePrefix("- "), ContinuationPrefix("  ")},
+			[]string{"- the quick ", "  brown fox ", "  jumps over ", "  the lazy ", "  dog"}, 13},
+
+		{"item", []SplitBuilderOption{FirstLinePrefix("- "), ContinuationPrefix("  ")},
+			[]string{"- item"}, 60},
+
+		{"term defined at length", []SplitBuilderOption{FirstLinePrefix("* "), ContinuationPrefix("      ")},
+			[]string{"* term defined ", "      at ", "      length"}, 15},
+
+		{"the quick brown fox", []SplitBuilderOption{LinePrefix("> "), FirstLinePrefix("1. ")},
+			[]string{"1. the ", "> quick ", "> brown ", "> fox"}, 9},
+
+		{"the quick brown fox", []SplitBuilderOption{ContinuationPrefix("    ")},
+			[]string{"the quick ", "    brown ", "    fox"}, 11},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
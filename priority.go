@@ -0,0 +1,28 @@
+package wordwrap
+
+// BreakPriorities makes each rune of priorities a break opportunity, after
+// the rune, with the given priority. Whitespace not listed has priority 0, so
+// a rune with a positive priority, such as a soft hyphen, is preferred over
+// spaces and one with a negative priority is only used when no space fits.
+//
+// Among the break opportunities fitting on a line, the line is broken at the
+// one with the highest priority, and among those with the same priority at
+// the last. Without priorities every opportunity has priority 0, so lines are
+// broken at the last which fits.
+func BreakPriorities(priorities map[rune]int) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.breakPriorities = priorities
+		sb.asIs = true
+	}
+}
+
+// breakPriority returns the priority of the break opportunity after r.
+func (sb *SplitBuilder) breakPriority(r rune) int {
+	return sb.breakPriorities[r]
+}
+
+// hasBreakPriority reports whether r is given a priority by BreakPriorities.
+func (sb *SplitBuilder) hasBreakPriority(r rune) bool {
+	_, ok := sb.breakPriorities[r]
+	return ok
+}
@@ -0,0 +1,44 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakPriorities(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"see docs/api ref", nil,
+			[]string{"see ", "docs/api ", "ref"}, 12},
+
+		{"see docs/api ref", []SplitBuilderOption{BreakPriorities(map[rune]int{'/': 0})},
+			[]string{"see docs/", "api ref"}, 12},
+
+		{"a b c/d e f", []SplitBuilderOption{BreakPriorities(map[rune]int{'/': 1})},
+			[]string{"a b c/", "d e f"}, 10},
+
+		{"a b c/d e f", []SplitBuilderOption{BreakPriorities(map[rune]int{'/': -1})},
+			[]string{"a b c/d e ", "f"}, 10},
+
+		{"un\u00adbreak\u00adable words", []SplitBuilderOption{BreakPriorities(map[rune]int{'\u00ad': 1})},
+			[]string{"un\u00adbreak\u00ad", "able words"}, 14},
+
+		{"a/b/c d", []SplitBuilderOption{BreakPriorities(map[rune]int{'/': 1})},
+			[]string{"a/b/", "c d"}, 6},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package wordwrap
+
+import "io"
+
+// reader is the io.Reader returned by NewReader.
+type reader struct {
+	r  io.Reader
+	ls *lineScanner
+
+	in, out []byte
+	chunk   []byte
+	eof     bool
+	sep     string
+	err     error
+}
+
+// NewReader returns an io.Reader serving the text read from r wrapped as a
+// SplitBuilder created with the given options would, the lines joined by
+// their separators, as Wrap would return it, whatever the options.
+//
+// Text is read from r only as it is needed to complete the next line, so
+// characters and words are never split across reads and r need not be held
+// in memory whole. Balanced and AutoScript look at the whole text to place
+// each line, so with either all of r is read before the first line.
+//
+// Errors from wrapping, such as ErrCharacterTooLarge, and from r are returned
+// once the lines before them have been read. Once MaxLines lines have been
+// read, Read returns ErrTruncated if any text remains.
+func NewReader(r io.Reader, byteLimit uint, options ...SplitBuilderOption) io.Reader {
+	return &reader{
+		r:     r,
+		ls:    &lineScanner{sb: NewSplitBuilder(options...), byteLimit: byteLimit},
+		chunk: make([]byte, 4096),
+	}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		r.fill()
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+
+	return n, nil
+}
+
+// fill wraps the next line of the input into the output, reading more of the
+// input when it is needed to be sure where the line ends.
+func (r *reader) fill() {
+	advance, l, found, err := r.ls.scan(r.in, r.eof)
+	if err != nil {
+		r.err = err
+		return
+	}
+
+	if found {
+		r.out = append(append(r.out[:0], r.sep...), l.text...)
+		r.sep = r.ls.sb.separator(l)
+	}
+
+	if advance > 0 || found {
+		r.in = append(r.in[:0], r.in[advance:]...)
+		return
+	}
+
+	if r.eof {
+		r.err = io.EOF
+		return
+	}
+
+	n, err := r.r.Read(r.chunk)
+	r.in = append(r.in, r.chunk[:n]...)
+	switch {
+	case err == io.EOF:
+		r.eof = true
+	case err != nil:
+		r.err = err
+	}
+}
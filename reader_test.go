@@ -0,0 +1,78 @@
+package wordwrap
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNewReader(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", nil, 4},
+		{"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕", nil, 9},
+		{"family 👨‍👩‍👧 and scientist 👩‍🔬 emoji", nil, 20},
+		{`If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die, and at his death his heir shall be of full age and owe a 'relief', the heir shall have his inheritance on payment of the ancient scale of 'relief'.`, nil, 60},
+		{"roses are **red**  \nviolets are _blue_ and so are you", []SplitBuilderOption{MarkdownHardBreaks(true), MarkdownInlineAware(true)}, 14},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{SoftBreakSeparator("\r\n")}, 10},
+		{"", nil, 10},
+	}
+
+	for _, test := range tests {
+		want := WrapString(test.input, test.bytelim)
+		if test.options != nil {
+			want, _ = NewSplitBuilder(test.options...).Wrap(test.input, test.bytelim)
+		}
+
+		for name, r := range map[string]io.Reader{
+			"whole":   NewReader(strings.NewReader(test.input), test.bytelim, test.options...),
+			"oneByte": NewReader(iotest.OneByteReader(strings.NewReader(test.input)), test.bytelim, test.options...),
+		} {
+			actual, err := ioutil.ReadAll(iotest.HalfReader(r))
+			if err != nil {
+				t.Fatalf(`NewReader(%#v) %s unexpected error: %s`, test.input, name, err)
+			}
+
+			if string(actual) != want {
+				t.Errorf(`NewReader(%#v) %s = %#v; want %#v`, test.input, name, string(actual), want)
+			}
+		}
+	}
+}
+
+func TestNewReader_error(t *testing.T) {
+	actual, err := ioutil.ReadAll(NewReader(strings.NewReader("ab しcd"), 2))
+	if err != ErrCharacterTooLarge {
+		t.Errorf(`Read error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if want := "ab\n "; string(actual) != want {
+		t.Errorf(`Read = %#v; want %#v`, string(actual), want)
+	}
+}
+
+func TestNewReader_options(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name, options := range streamingOptions {
+		sb := NewSplitBuilder(options...)
+		for i := 0; i < 300; i++ {
+			input, bytelim := randomText(r, 30), uint(r.Intn(14)+1)
+			want, wantErr := sb.Wrap(input, bytelim)
+
+			actual, err := ioutil.ReadAll(NewReader(iotest.OneByteReader(strings.NewReader(input)), bytelim, options...))
+			if err != wantErr && !(wantErr == nil && err == ErrTruncated) {
+				t.Errorf(`%s: NewReader(%#v, %d) error = %v; want %v`, name, input, bytelim, err, wantErr)
+			}
+
+			if string(actual) != want {
+				t.Errorf(`%s: NewReader(%#v, %d) = %#v; want %#v`, name, input, bytelim, string(actual), want)
+			}
+		}
+	}
+}
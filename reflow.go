@@ -0,0 +1,65 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// OnlyReflowOverLong wraps only the lines of the input which exceed their
+// limit, passing lines which fit through byte for byte, including their
+// whitespace. This keeps diffs minimal when reformatting comments or config
+// files.
+//
+// Every newline of the input ends a line, as with preserved newlines, and is
+// dropped from the output along with a carriage return preceding it. Empty
+// input lines produce empty lines. Lines passed through are not altered by
+// MarkdownInlineAware.
+func OnlyReflowOverLong(only bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.onlyReflowOverLong = only
+		sb.asIs = true
+	}
+}
+
+// passThrough emits the input line starting at byte offset i as is if it fits
+// within the limit, returning the offset of the following line.
+func (sp *splitter) passThrough(i int) (int, bool) {
+	end := i
+	for end < len(sp.s) && sp.s[end] != '\n' {
+		end++
+	}
+
+	next := end
+	if end < len(sp.s) {
+		next++
+	}
+
+	if end > i && sp.s[end-1] == '\r' && end < len(sp.s) {
+		end--
+	}
+
+	if sp.sb.measure(sp.s[i:end]) > sp.limit() {
+		return i, false
+	}
+
+	if sp.sb.invalidUTF8 != InvalidUTF8Keep && !utf8.ValidString(sp.s[i:end]) {
+		return i, false
+	}
+
+	for j := i; j < next; j += charSize(sp.s[j:]) {
+		sp.clusters++
+	}
+
+	l := line{text: sp.s[i:end], start: i, end: end, mdOpen: sp.mdOpen, newline: next > end, hard: next > end}
+	if sp.collect {
+		for j := i; j < end; {
+			r, size := utf8.DecodeRuneInString(sp.s[j:])
+			if sp.breakAfter(j, r, size) && j+size < len(sp.s) {
+				l.opportunities = append(l.opportunities, j+size)
+			}
+			j += size
+		}
+	}
+
+	sp.push(l)
+	sp.line++
+
+	return next, true
+}
@@ -0,0 +1,39 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOnlyReflowOverLong(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"short  \n\nthis line is too long\n  fits ok\n", nil,
+			[]string{"short  ", "", "this line ", "is too ", "long", "  fits ok"}, 11},
+
+		{"a  b\r\nccc ddd eee\r\n", nil,
+			[]string{"a  b", "ccc ddd ", "eee"}, 8},
+
+		{"abc def\nab cd", []SplitBuilderOption{FirstLineLimit(4)},
+			[]string{"abc ", "def", "ab cd"}, 8},
+
+		{"**a b c**\nd", []SplitBuilderOption{MarkdownInlineAware(true)},
+			[]string{"**a b c**", "d"}, 10},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append([]SplitBuilderOption{OnlyReflowOverLong(true)}, test.options...)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
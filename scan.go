@@ -0,0 +1,348 @@
+package wordwrap
+
+import (
+	"bufio"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ScanWrappedLines returns a bufio.SplitFunc which splits its input into
+// wrapped lines as a SplitBuilder created with the given options would, so a
+// bufio.Scanner yields one wrapped line per Scan over any io.Reader.
+//
+// A line is only returned once enough of the input has been read to be sure
+// where it ends, so characters and words are never split across buffer
+// refills, and the lines are those SplitString returns for the whole input.
+// Balanced and AutoScript look at the whole input to place each line, so with
+// either no line is returned before the input ends, and all of it must fit in
+// the buffer of the Scanner. The returned SplitFunc carries state between
+// lines and must not be shared between Scanners.
+//
+// Once MaxLines lines have been returned, the SplitFunc returns ErrTruncated
+// if any input remains, stopping the Scanner without reading further.
+func ScanWrappedLines(byteLimit uint, options ...SplitBuilderOption) bufio.SplitFunc {
+	ls := &lineScanner{sb: NewSplitBuilder(options...), byteLimit: byteLimit}
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, l, found, err := ls.scan(data, atEOF)
+		if !found {
+			return advance, nil, err
+		}
+
+		return advance, []byte(l.text), nil
+	}
+}
+
+// lineScanner wraps input arriving in pieces one line at a time, carrying the
+// state of the wrapping from one line to the next.
+type lineScanner struct {
+	sb        *SplitBuilder
+	byteLimit uint
+
+	lineIndex  int
+	mdOpen     []string
+	prefix     string
+	afterSpace bool
+	continued  bool
+
+	// context holds the end of the input consumed so far, as far back as
+	// splitting the input following it may look
+	context []byte
+	buf     []byte
+
+	// whole holds the lines left of input split whole, per wholeInput, and
+	// wholeErr the error ending them
+	whole    []line
+	wholeErr error
+	split    bool
+}
+
+// scanWindow is the number of bytes of the input first looked at for a line,
+// doubled until the line is found, such that each line costs about its own
+// length to find however much input is buffered.
+const scanWindow = 4096
+
+// lastCharLookback is the number of bytes at the end of the input looked at
+// for the start of the last character, extended backward while the characters
+// found may continue one starting earlier.
+const lastCharLookback = 64
+
+// splitLookaround is the number of bytes around a character splitting may look
+// at to place it, besides the words, runs of spaces, phrases and line prefixes
+// some options look over whole.
+const splitLookaround = 32
+
+// scan returns the first line of data, if it is certain where the line ends,
+// along with the number of bytes of data it consumed, following the contract
+// of bufio.SplitFunc. found is false when more data is needed, or at EOF when
+// nothing is left to return.
+func (ls *lineScanner) scan(data []byte, atEOF bool) (advance int, l line, found bool, err error) {
+	if ls.sb.wholeInput() {
+		return ls.scanWhole(data, atEOF)
+	}
+
+	for window := scanWindow; window < len(data); window *= 2 {
+		advance, l, found, err = ls.scanLine(data[:window], false)
+		if found || err != nil {
+			return advance, l, found, err
+		}
+	}
+
+	return ls.scanLine(data, atEOF)
+}
+
+// scanLine returns the first line of data as scan does, looking at all of it.
+func (ls *lineScanner) scanLine(data []byte, atEOF bool) (advance int, l line, found bool, err error) {
+	if !atEOF {
+		// a rune cut by the end of the buffer is not invalid, and the
+		// character ending it may continue in the next
+		data = data[:len(data)-partialRuneLen(data)]
+		data = data[:ls.sb.lastCharStart(string(data))]
+	}
+
+	// the input consumed before data is split again as context, from which
+	// splitting only resumes at data
+	ls.buf = append(append(ls.buf[:0], ls.context...), data...)
+	s, from := string(ls.buf), len(ls.context)
+
+	var (
+		next   = len(s)
+		read   int
+		prefix string
+		sp     *splitter
+	)
+
+	sp = ls.newSplitter(s, func(first line) bool {
+		l, found, read, prefix = first, true, sp.read, sp.prefix
+		if sp.queued {
+			next, prefix = sp.pending.start, sp.pending.prefix
+		} else if len(sp.chars) > 0 {
+			next = sp.chars[0].pos
+		}
+
+		return false
+	})
+	sp.from, sp.final = from, atEOF
+
+	err = sp.run()
+	switch {
+	case !found && err != nil:
+		return 0, line{}, false, err
+	case !found && sp.truncated:
+		return 0, line{}, false, ErrTruncated
+	case !found && atEOF:
+		// everything left was dropped from the output
+		ls.consume(s, len(s))
+		return len(data), line{}, false, nil
+	case !found || !atEOF && !ls.sb.settled(s, read):
+		return 0, line{}, false, nil
+	}
+
+	ls.lineIndex++
+	ls.mdOpen, ls.prefix = l.mdOpen, prefix
+	ls.continued = !l.hard
+	if ls.sb.collapseWhitespace && next > 0 {
+		r, _ := utf8.DecodeLastRuneInString(s[:next])
+		ls.afterSpace = !l.hard && ls.sb.collapsible(r)
+	}
+	ls.consume(s, next)
+
+	return next - from, l, true, nil
+}
+
+// scanWhole returns the lines of data one at a time as scan does, splitting
+// all of it at once when the input ends, as the options per wholeInput must.
+func (ls *lineScanner) scanWhole(data []byte, atEOF bool) (advance int, l line, found bool, err error) {
+	if !atEOF {
+		return 0, line{}, false, nil
+	}
+
+	if !ls.split {
+		s := string(data)
+		sp := ls.newSplitter(s, func(l line) bool {
+			ls.whole = append(ls.whole, l)
+			return true
+		})
+		sp.final = true
+
+		ls.wholeErr = sp.run()
+		if ls.wholeErr == nil && sp.truncated {
+			ls.wholeErr = ErrTruncated
+		}
+
+		ls.split = true
+		ls.lineIndex, ls.mdOpen, ls.prefix = sp.line, sp.mdOpen, sp.prefix
+		ls.afterSpace, ls.continued = sp.afterSpace, sp.continued
+	}
+
+	if len(ls.whole) == 0 {
+		ls.split = false
+		if ls.wholeErr != nil {
+			return 0, line{}, false, ls.wholeErr
+		}
+
+		ls.consume(string(data), len(data))
+		return len(data), line{}, false, nil
+	}
+
+	l, ls.whole = ls.whole[0], ls.whole[1:]
+	if len(ls.whole) == 0 && ls.wholeErr == nil {
+		// the last line consumes the input
+		ls.split = false
+		ls.consume(string(data), len(data))
+		return len(data), l, true, nil
+	}
+
+	return 0, l, true, nil
+}
+
+// newSplitter returns a splitter for s, resuming the wrapping where the line
+// last scanned left it.
+func (ls *lineScanner) newSplitter(s string, yield func(l line) bool) *splitter {
+	sp := ls.sb.newSplitter(s, ls.byteLimit, yield)
+	sp.line, sp.mdOpen, sp.prefix, sp.streaming = ls.lineIndex, ls.mdOpen, ls.prefix, true
+	sp.afterSpace, sp.continued = ls.afterSpace, ls.continued
+
+	return sp
+}
+
+// consume records the input s up to offset n as consumed, keeping as much of
+// its end as context as splitting the input following it may look back on.
+func (ls *lineScanner) consume(s string, n int) {
+	ls.context = append(ls.context[:0], s[ls.sb.contextStart(s[:n]):n]...)
+}
+
+// wholeInput reports whether the options look at the whole input to place
+// each line, such that lines can't be scanned before the input ends.
+func (sb *SplitBuilder) wholeInput() bool {
+	return sb.balanced || sb.autoScript
+}
+
+// contextStart returns the offset in s, the input consumed so far, from which
+// splitting the input following it may look back: a few characters, the word
+// or run of spaces ending s for hyphenation and Markdown hard breaks, and the
+// longest phrase kept together.
+func (sb *SplitBuilder) contextStart(s string) int {
+	i := len(s) - splitLookaround - sb.longestKept()
+	if i <= 0 {
+		return 0
+	}
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
+	}
+
+	if sb.hyphenator != nil || sb.markdownHardBreaks {
+		j := len(s)
+		if j > 0 {
+			last, _ := utf8.DecodeLastRuneInString(s)
+			for j > 0 {
+				r, size := utf8.DecodeLastRuneInString(s[:j])
+				if sb.isSpace(r) != sb.isSpace(last) {
+					break
+				}
+				j -= size
+			}
+		}
+
+		if j < i {
+			_, size := utf8.DecodeLastRuneInString(s[:j])
+			i = j - size
+		}
+	}
+
+	return i
+}
+
+// settled reports whether the lines of s split up to offset i, the input read
+// so far, are certain however the input continues past s, looking as far
+// ahead of i as the options do.
+func (sb *SplitBuilder) settled(s string, i int) bool {
+	ahead := s[i:]
+	if len(ahead) == 0 || len(ahead) < sb.lookahead() {
+		return false
+	}
+
+	if sb.hyphenator == nil && !sb.markdownHardBreaks {
+		return true
+	}
+
+	// the word or run of spaces at i must end within s
+	first, _ := utf8.DecodeRuneInString(ahead)
+	for _, r := range ahead {
+		if sb.isSpace(r) != sb.isSpace(first) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookahead returns the number of bytes past a character splitting may look
+// at to place it, beyond the character following it.
+func (sb *SplitBuilder) lookahead() int {
+	n := sb.longestKept() + sb.longestPrefix()
+	if n > 0 || sb.keepNumberUnitTogether || sb.markdownInlineAware || sb.markdownHardBreaks {
+		n += splitLookaround
+	}
+
+	return n
+}
+
+// lastCharStart returns the offset of the last character of s, looking only
+// at the end of s.
+func (sb *SplitBuilder) lastCharStart(s string) int {
+	from := len(s) - lastCharLookback
+	if from < 0 {
+		from = 0
+	}
+	for from > 0 && !utf8.RuneStart(s[from]) {
+		from--
+	}
+	from = sb.charStartBefore(s, from)
+
+	last := from
+	for i := from; i < len(s); i += sb.charSize(s[i:]) {
+		last = i
+	}
+
+	return last
+}
+
+// charStartBefore returns the offset of a rune of s at or before i which
+// certainly starts a character, stepping back over runes which may continue
+// the character before them: joiners and the runes they join, emoji
+// extenders, combining marks, regional indicators, and the bytes of escape
+// sequences per IgnoreANSI.
+func (sb *SplitBuilder) charStartBefore(s string, i int) int {
+	for i > 0 {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		prev, size := utf8.DecodeLastRuneInString(s[:i])
+
+		continues := r == zeroWidthJoiner || prev == zeroWidthJoiner ||
+			isEmojiExtender(r) || unicode.In(r, unicode.Mn, unicode.Me) ||
+			isRegionalIndicator(prev) && isRegionalIndicator(r) ||
+			sb.ignoreANSI && (prev == '\x1b' || prev >= 0x20 && prev <= 0x3f || prev == '[')
+		if !continues {
+			break
+		}
+
+		i -= size
+	}
+
+	return i
+}
+
+// partialRuneLen returns the length of the incomplete rune ending data, if any.
+func partialRuneLen(data []byte) int {
+	for k := 1; k < utf8.UTFMax && k <= len(data); k++ {
+		if utf8.RuneStart(data[len(data)-k]) {
+			if utf8.FullRune(data[len(data)-k:]) {
+				return 0
+			}
+
+			return k
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,157 @@
+package wordwrap
+
+import (
+	"bufio"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScanWrappedLines(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", nil, 4},
+		{"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕", nil, 9},
+		{"family 👨‍👩‍👧 and scientist 👩‍🔬 emoji", nil, 20},
+		{`If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die, and at his death his heir shall be of full age and owe a 'relief', the heir shall have his inheritance on payment of the ancient scale of 'relief'.`, nil, 60},
+		{`クラウンの直接土地を保持している任意の伯爵、男爵、または他の人は、兵役のために、死ぬ、と彼の死で彼の後継者は成年であることと「救済」を借りなければならない場合は`, nil, 60},
+		{"roses are **red**  \nviolets are _blue_ and so are you", []SplitBuilderOption{MarkdownHardBreaks(true), MarkdownInlineAware(true)}, 14},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{FirstLineLimit(6), PadLastLine(true)}, 16},
+		{"12 kg", []SplitBuilderOption{KeepNumberUnitTogether(true)}, 2},
+		{"ab-cd", []SplitBuilderOption{BreakOnHyphens(true)}, 2},
+		{"ab-cd", []SplitBuilderOption{UseUAX14(true)}, 2},
+		{"", []SplitBuilderOption{MinLines(2)}, 10},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{Balanced(true)}, 16},
+	}
+
+	for _, test := range tests {
+		want, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(test.input)))
+		scanner.Split(ScanWrappedLines(test.bytelim, test.options...))
+
+		actual := []string{}
+		for scanner.Scan() {
+			actual = append(actual, scanner.Text())
+		}
+
+		if err := scanner.Err(); err != nil {
+			t.Fatalf(`Scan(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf(`Scan(%#v) = %#v; want %#v`, test.input, actual, want)
+		}
+	}
+}
+
+func TestScanWrappedLines_error(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("ab し"))
+	scanner.Split(ScanWrappedLines(2))
+
+	actual := []string{}
+	for scanner.Scan() {
+		actual = append(actual, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != ErrCharacterTooLarge {
+		t.Errorf(`Scan error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if want := []string{"ab", " "}; !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Scan = %#v; want %#v`, actual, want)
+	}
+}
+
+// streamingOptions are the option sets the streaming APIs are checked against
+// splitting whole, among them those looking back and ahead of each character.
+var streamingOptions = map[string][]SplitBuilderOption{
+	"none":                 nil,
+	"numberUnit":           {KeepNumberUnitTogether(true)},
+	"hyphens":              {BreakOnHyphens(true)},
+	"uax14":                {UseUAX14(true)},
+	"limitFunc":            {LimitFunc(func(i int, limit uint) uint { return limit + uint(i%3) })},
+	"hyphenator":           {WithHyphenator(HyphenatorFunc(func(word string) []string { return hyphenateEvery(word, 2) }))},
+	"detectPrefix":         {DetectLinePrefix("> ", "# ")},
+	"firstLinePrefix":      {FirstLinePrefix("* "), ContinuationPrefix("  ")},
+	"markdownHardBreaks":   {MarkdownHardBreaks(true)},
+	"markdownInline":       {MarkdownInlineAware(true)},
+	"autoScript":           {AutoScript(true)},
+	"invalidSkip":          {InvalidUTF8Policy(InvalidUTF8Skip)},
+	"balanced":             {Balanced(true)},
+	"minLines":             {MinLines(4), PadLastLine(true)},
+	"emptyInputYieldsLine": {EmptyInputYieldsLine(true)},
+	"collapse":             {CollapseWhitespace(true), PreserveNewlines(true)},
+	"onlyReflowOverLong":   {OnlyReflowOverLong(true)},
+	"keepTogether":         {KeepTogether([]string{"ab cd", "12 3"})},
+	"tabs":                 {ExpandTabs(4), PreserveNewlines(true)},
+	"trim":                 {TrimTrailingWhiteSpace(true), TrimLeadingWhiteSpace(true)},
+	"justify":              {Justify(true), LinePrefix("| ")},
+	"align":                {Align(AlignRight)},
+	"compact":              {CompactShortLines(3)},
+	"maxLines":             {MaxLines(3), Ellipsis("~")},
+	"breaks":               {BreakOnZeroWidthSpace(true), BreakOnSoftHyphen(true), HonorNonBreakingSpace(true)},
+	"coalesce":             {CoalesceBreakRuns(true)},
+	"wide":                 {MaxWideCharsPerLine(2)},
+	"box":                  {BoxBorders("[", "]")},
+}
+
+// hyphenateEvery returns word cut into fragments of n runes.
+func hyphenateEvery(word string, n int) []string {
+	var fragments []string
+	runes := []rune(word)
+	for len(runes) > n {
+		fragments = append(fragments, string(runes[:n]))
+		runes = runes[n:]
+	}
+
+	return append(fragments, string(runes))
+}
+
+// randomText returns a random text of up to n pieces, mixing words, numbers
+// and units, hyphens, Markdown, line prefixes, wide and invalid characters.
+func randomText(r *rand.Rand, n int) string {
+	pieces := []string{"ab", "cd", "efghij", "12", "kg", " ", " ", "  ", "-", "\n", "> ", "# ", "*", "_", "`", "\t", "漢字", "é", "\xff", "​", "­", " ", "3"}
+
+	var b strings.Builder
+	for i := r.Intn(n + 1); i > 0; i-- {
+		b.WriteString(pieces[r.Intn(len(pieces))])
+	}
+
+	return b.String()
+}
+
+func TestScanWrappedLines_options(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name, options := range streamingOptions {
+		sb := NewSplitBuilder(options...)
+		for i := 0; i < 500; i++ {
+			input, bytelim := randomText(r, 30), uint(r.Intn(14)+1)
+			want, wantErr := sb.SplitString(input, bytelim)
+
+			scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+			scanner.Split(ScanWrappedLines(bytelim, options...))
+
+			actual := []string{}
+			for scanner.Scan() {
+				actual = append(actual, scanner.Text())
+			}
+
+			if err := scanner.Err(); err != wantErr && !(wantErr == nil && err == ErrTruncated) {
+				t.Errorf(`%s: Scan(%#v, %d) error = %v; want %v`, name, input, bytelim, err, wantErr)
+			}
+
+			if !reflect.DeepEqual(actual, want) {
+				t.Errorf(`%s: Scan(%#v, %d) = %#v; want %#v`, name, input, bytelim, actual, want)
+			}
+		}
+	}
+}
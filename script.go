@@ -0,0 +1,145 @@
+package wordwrap
+
+import "unicode"
+
+// AutoScript detects the dominant script of each input from a sample of its
+// letters and applies break defaults suited to it:
+//
+//   - Han, Hiragana and Katakana text, written without spaces, may break
+//     after any of their characters rather than only at the limit.
+//   - Hebrew, Arabic and other right-to-left text gets a right-to-left
+//     BaseDirection.
+//   - Latin and other scripts, including Thai for which no dictionary is
+//     available, keep the space based defaults.
+//
+// The sample is the first 256 letters of the input, and the script with the
+// most letters among them wins. Explicit options override the detection: no
+// break is added when a WhitespaceFunc is set, and a BaseDirection other than
+// the default left-to-right is kept.
+func AutoScript(auto bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.autoScript = auto
+		sb.asIs = true
+	}
+}
+
+// script is a group of scripts sharing break defaults.
+type script int
+
+const (
+	scriptOther script = iota
+	scriptCJK
+	scriptRTL
+)
+
+const scriptSample = 256
+
+var cjkScripts = []*unicode.RangeTable{
+	unicode.Han, unicode.Hiragana, unicode.Katakana,
+}
+
+// commonScripts are the scripts looked up first when naming the script of a
+// letter, before the rest of unicode.Scripts.
+var commonScripts = []string{
+	"Latin", "Han", "Hiragana", "Katakana", "Hangul", "Cyrillic", "Greek",
+	"Arabic", "Hebrew", "Thai", "Devanagari",
+}
+
+// letterScript returns the name of the script of the letter r as found in
+// unicode.Scripts, or "" if it has none.
+func letterScript(r rune) string {
+	for _, name := range commonScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// dominantScript returns the script group with the most letters among the
+// first letters of s, along with the name of the script with the most letters
+// within the group, or "" if s has no letters.
+func dominantScript(s string) (script, string) {
+	var counts [3]int
+	names := []string{}
+	byName := map[string]int{}
+	groups := map[string]script{}
+
+	letters := 0
+	for _, r := range s {
+		if letters == scriptSample {
+			break
+		}
+
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		letters++
+		group := scriptOther
+		switch {
+		case unicode.In(r, cjkScripts...):
+			group = scriptCJK
+		case unicode.In(r, rtlScripts...):
+			group = scriptRTL
+		}
+		counts[group]++
+
+		name := letterScript(r)
+		if _, ok := byName[name]; !ok {
+			names = append(names, name)
+			groups[name] = group
+		}
+		byName[name]++
+	}
+
+	best := scriptOther
+	for sc, n := range counts {
+		if n > counts[best] {
+			best = script(sc)
+		}
+	}
+
+	bestName := ""
+	for _, name := range names {
+		if groups[name] == best && (bestName == "" || byName[name] > byName[bestName]) {
+			bestName = name
+		}
+	}
+
+	return best, bestName
+}
+
+// forScript returns the SplitBuilder to split text of the script group sc
+// with, which is sb itself unless AutoScript applies defaults for it.
+func (sb *SplitBuilder) forScript(sc script) *SplitBuilder {
+	switch sc {
+	case scriptCJK:
+		if sb.whitespaceFunc == nil {
+			auto := *sb
+			auto.cjkBreaks = true
+			return &auto
+		}
+	case scriptRTL:
+		if sb.baseDirection == DirectionLTR {
+			auto := *sb
+			auto.baseDirection = DirectionRTL
+			return &auto
+		}
+	}
+
+	return sb
+}
+
+// isCJKBreak reports whether a line may break after the rune r as a CJK
+// character or punctuation.
+func isCJKBreak(r rune) bool {
+	return unicode.In(r, cjkScripts...) || r >= 0x3000 && r <= 0x303f || r >= 0xff01 && r <= 0xff60
+}
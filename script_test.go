@@ -0,0 +1,53 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutoScript(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"go 東京駅です", nil,
+			[]string{"go ", "東京駅", "です"}, 10},
+
+		{"go 東京駅です", []SplitBuilderOption{AutoScript(true)},
+			[]string{"go 東京", "駅です"}, 10},
+
+		{"東京 Tokyo", []SplitBuilderOption{AutoScript(true)},
+			[]string{"東京 ", "Tokyo"}, 8},
+
+		{"東京へ行くab", []SplitBuilderOption{AutoScript(true)},
+			[]string{"東京へ行く", "ab"}, 16},
+
+		{"東京へ行くab", nil,
+			[]string{"東京へ行くa", "b"}, 16},
+
+		{"tokyo 東京へ行くab", []SplitBuilderOption{AutoScript(true)},
+			[]string{"tokyo ", "東京へ行くa", "b"}, 16},
+
+		{"東京へ行くab", []SplitBuilderOption{AutoScript(true), WhitespaceFunc(func(r rune) bool { return r == ' ' })},
+			[]string{"東京へ行くa", "b"}, 16},
+
+		{" שלום עולם ", []SplitBuilderOption{AutoScript(true), TrimVisualEnd(true)},
+			[]string{"שלום ", "עולם "}, 11},
+
+		{" שלום עולם ", []SplitBuilderOption{TrimVisualEnd(true)},
+			[]string{" שלום ", "עולם "}, 11},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package wordwrap
+
+import "unicode/utf8"
+
+const zeroWidthJoiner = '\u200d'
+
+// charSize returns the size of the character at the start of s: a rune along
+// with any runes joined to it by zero-width joiners, such that emoji sequences
+// like "👩‍🔬" are not broken, which would leave a dangling joiner. A pair of
+// regional indicators, as in the flag "🇺🇸", counts as one rune, as does a
+// rune followed by emoji modifiers, variation selectors, a keycap or tags, as
+// in "👋🏽" or "1️⃣". A sequence of runes joined by zero-width joiners too
+// large for a line alone is broken between them, dropping the joiners at the
+// breaks, unless an OversizeHandler is set.
+func charSize(s string) int {
+	n := componentSize(s)
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r != zeroWidthJoiner {
+			break
+		}
+
+		n += size
+		if n < len(s) {
+			n += componentSize(s[n:])
+		}
+	}
+
+	return n
+}
+
+// componentSize returns the size of the rune at the start of s, or of the pair
+// of regional indicators making up a flag, along with the emoji extenders
+// following it.
+func componentSize(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	if isRegionalIndicator(r) && n < len(s) {
+		if next, size := utf8.DecodeRuneInString(s[n:]); isRegionalIndicator(next) {
+			n += size
+		}
+	}
+
+	for n < len(s) {
+		next, size := utf8.DecodeRuneInString(s[n:])
+		if !isEmojiExtender(next) {
+			break
+		}
+
+		n += size
+	}
+
+	return n
+}
+
+// isEmojiExtender reports whether r extends the emoji before it: a variation
+// selector, a skin tone modifier, the combining keycap or a tag.
+func isEmojiExtender(r rune) bool {
+	return r >= 0xfe00 && r <= 0xfe0f ||
+		r >= 0x1f3fb && r <= 0x1f3ff ||
+		r == 0x20e3 ||
+		r >= 0xe0020 && r <= 0xe007f
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// breakJoined breaks a character joined by zero-width joiners into pieces of
+// as many joined components as fit within limit. The joiners between pieces
+// are dropped so no piece starts or ends with a dangling joiner. It returns
+// nil if a single component doesn't fit.
+func (sp *splitter) breakJoined(c charPos, limit uint) []charPos {
+	s, measure := sp.s, sp.sb.measure
+
+	var pieces []charPos
+	for i := c.pos; i < c.end(); {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		start, end := i, i+componentSize(s[i:])
+		i = end
+
+		if r == zeroWidthJoiner {
+			continue
+		}
+
+		if measure(s[start:end]) > limit {
+			return nil
+		}
+
+		if n := len(pieces); n > 0 && measure(s[pieces[n-1].pos:end]) <= limit {
+			pieces[n-1].size = end - pieces[n-1].pos
+			continue
+		}
+
+		pieces = append(pieces, charPos{pos: start, size: end - start})
+	}
+
+	if len(pieces) == 0 {
+		return nil
+	}
+
+	for i := range pieces {
+		pieces[i].width = measure(s[pieces[i].pos:pieces[i].end()])
+	}
+	pieces[len(pieces)-1].brk, pieces[len(pieces)-1].prio = c.brk, c.prio
+
+	return pieces
+}
+
+// breakSequence breaks the first character of the working line, which
+// doesn't fit on the line alone, between the components joined by its
+// zero-width joiners per breakJoined, emitting each piece but the last as a
+// line of its own. It reports whether the character was broken.
+func (sp *splitter) breakSequence() bool {
+	c := sp.chars[0]
+	if c.replaced {
+		return false
+	}
+
+	pieces := sp.breakJoined(c, sp.limit())
+	if len(pieces) < 2 {
+		return false
+	}
+
+	sp.chars = append(pieces, sp.chars[1:]...)
+	sp.width = 0
+	for _, c := range sp.chars {
+		sp.width += c.width
+	}
+
+	for n := len(pieces) - 1; n > 0 && !sp.done; n-- {
+		sp.emit(1)
+	}
+
+	return true
+}
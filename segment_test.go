@@ -0,0 +1,175 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitBuilder_SplitString_zeroWidthJoiner(t *testing.T) {
+	tests := []struct {
+		input   string
+		action  Action
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"hi 👩‍🔬 there",
+			ActionAbort, []string{"hi ", "👩‍🔬 ", "there"}, nil, 12},
+
+		{"a 👨‍👩‍👧 b",
+			ActionAbort, []string{"a ", "👨‍👩‍👧 ", "b"}, nil, 20},
+
+		{"👨‍👩‍👧",
+			ActionAbort, []string{}, ErrCharacterTooLarge, 10},
+
+		{"👨‍👩‍👧",
+			ActionBreak, []string{"👨‍👩", "👧"}, nil, 11},
+
+		{"👨‍👩‍👧 👨‍🚒",
+			ActionBreak, []string{"👨", "👩", "👧 ", "👨", "🚒"}, nil, 5},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(OversizeHandler(func(string, uint) (string, Action) {
+			return "", test.action
+		}))
+
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		for _, line := range actual {
+			first, _ := utf8.DecodeRuneInString(line)
+			last, _ := utf8.DecodeLastRuneInString(line)
+			if first == zeroWidthJoiner || last == zeroWidthJoiner {
+				t.Errorf(`SplitString(%#v) line %#v has a dangling joiner`, test.input, line)
+			}
+		}
+	}
+}
+
+func TestSplitBuilder_SplitString_regionalIndicators(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"Hello 🇺🇸 test", nil,
+			[]string{"Hello ", "🇺🇸 ", "test"}, nil, 9},
+
+		{"ab🇺🇸cd", nil,
+			[]string{"ab", "🇺🇸", "cd"}, nil, 8},
+
+		{"🇺🇸🇫🇷", nil,
+			[]string{"🇺🇸", "🇫🇷"}, nil, 8},
+
+		{"x🇺🇸", nil,
+			[]string{"x"}, ErrCharacterTooLarge, 4},
+
+		{"🇺🇸🇫", nil,
+			[]string{"🇺🇸", "🇫"}, nil, 8},
+
+		{"Hi 🇺🇸 ok", []SplitBuilderOption{MeasureBy(MeasureConservative)},
+			[]string{"Hi ", "🇺🇸 ", "ok"}, nil, 9},
+
+		{"🇺🇸\u200d🇫🇷", []SplitBuilderOption{OversizeHandler(func(string, uint) (string, Action) {
+			return "", ActionBreak
+		})},
+			[]string{"🇺🇸", "🇫🇷"}, nil, 8},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitBuilder_SplitString_emojiExtenders(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"hi 👋🏽 there",
+			[]string{"hi ", "👋🏽 ", "there"}, nil, 9},
+
+		{"👋🏽👋🏽",
+			[]string{"👋🏽", "👋🏽"}, nil, 8},
+
+		{"👋🏽",
+			[]string{}, ErrCharacterTooLarge, 4},
+
+		{"1\ufe0f\u20e32\ufe0f\u20e3",
+			[]string{"1\ufe0f\u20e3", "2\ufe0f\u20e3"}, nil, 7},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder().SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitString_sequenceTooLarge(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"👩\u200d🔬",
+			[]string{"👩", "🔬"}, nil, 8},
+
+		{"👩\u200d🔬",
+			[]string{"👩", "🔬"}, nil, 4},
+
+		{"a 👩\u200d🔬 b",
+			[]string{"a ", "👩", "🔬 b"}, nil, 8},
+
+		{"👨\u200d👩\u200d👧",
+			[]string{"👨\u200d👩", "👧"}, nil, 12},
+
+		{"👨\u200d👩\u200d👧",
+			[]string{"👨", "👩", "👧"}, nil, 4},
+
+		{"👋🏽\u200d👋",
+			[]string{"👋🏽", "👋"}, nil, 8},
+
+		{"👋🏽\u200d👋",
+			[]string{}, ErrCharacterTooLarge, 4},
+
+		{"🇺🇸",
+			[]string{}, ErrCharacterTooLarge, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := DefaultSplitBuilder.SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
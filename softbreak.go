@@ -0,0 +1,46 @@
+package wordwrap
+
+// LineSeparator sets the separator placed between lines when they are joined,
+// as by Wrap, WrapAndSplit, WrapWithStats and Lazy, such as "\r\n" for
+// Windows consumers or "<br>" for HTML. The default is \n. Split lines are
+// unaffected.
+func LineSeparator(sep string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.lineSeparatorSet, sb.lineSeparator = true, sep
+		sb.asIs = true
+	}
+}
+
+// SoftBreakSeparator sets the separator placed after lines broken by wrapping
+// when lines are joined, as by WrapAndSplit, WrapWithStats and Lazy. Lines
+// ending at a hard break of the input, such as a Markdown hard break, a
+// newline kept by OnlyReflowOverLong or a mandatory break, are still followed
+// by the LineSeparator. A renderer can then tell the soft breaks it may
+// reflow on resize from the hard ones it must keep.
+//
+// By default every line is followed by the LineSeparator. Split lines are
+// unaffected.
+func SoftBreakSeparator(sep string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.softBreakSet, sb.softBreak = true, sep
+		sb.asIs = true
+	}
+}
+
+// separator returns the separator following l when lines are joined.
+func (sb *SplitBuilder) separator(l line) string {
+	if l.hard || !sb.softBreakSet {
+		return sb.lineSep()
+	}
+
+	return sb.softBreak
+}
+
+// lineSep returns the LineSeparator, \n unless set.
+func (sb *SplitBuilder) lineSep() string {
+	if !sb.lineSeparatorSet {
+		return "\n"
+	}
+
+	return sb.lineSeparator
+}
@@ -0,0 +1,96 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSoftBreakSeparator(t *testing.T) {
+	strict, err := StrictProtocolMode(';', 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		lines   []string
+		wrapped string
+		bytelim uint
+	}{
+		{"aaa bbb ccc", []SplitBuilderOption{SoftBreakSeparator(" ")},
+			[]string{"aaa ", "bbb ", "ccc"}, "aaa  bbb  ccc", 4},
+
+		{"aaa bbb\nccc", []SplitBuilderOption{SoftBreakSeparator("~"), OnlyReflowOverLong(true)},
+			[]string{"aaa ", "bbb", "ccc"}, "aaa ~bbb\nccc", 4},
+
+		{"roses are red  \nviolets", []SplitBuilderOption{SoftBreakSeparator(""), MarkdownHardBreaks(true)},
+			[]string{"roses are ", "red  ", "violets"}, "roses are red  \nviolets", 10},
+
+		{"a;bbbcc;d", []SplitBuilderOption{strict, SoftBreakSeparator("~")},
+			[]string{"a;", "bbbc", "c;", "d"}, "a;\nbbbc~c;\nd", 4},
+
+		{"aaa bbb", nil,
+			[]string{"aaa ", "bbb"}, "aaa \nbbb", 4},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(test.options...)
+		lines, wrapped, err := sb.WrapAndSplit(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`WrapAndSplit(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(lines, test.lines) || wrapped != test.wrapped {
+			t.Errorf(`WrapAndSplit(%#v) = %#v, %#v; want %#v, %#v`, test.input, lines, wrapped, test.lines, test.wrapped)
+		}
+
+		if stats, _, _ := sb.WrapWithStats(test.input, test.bytelim); stats != test.wrapped {
+			t.Errorf(`WrapWithStats(%#v) = %#v; want %#v`, test.input, stats, test.wrapped)
+		}
+
+		if lazy := Lazy(test.input, test.bytelim, test.options...).String(); lazy != test.wrapped {
+			t.Errorf(`Lazy(%#v) = %#v; want %#v`, test.input, lazy, test.wrapped)
+		}
+	}
+}
+
+func TestLineSeparator(t *testing.T) {
+	const input = "the quick brown fox jumps over the lazy dog"
+
+	for _, sep := range []string{"\r\n", "<br>", "\n", ""} {
+		sb := NewSplitBuilder(LineSeparator(sep))
+
+		lines, err := sb.SplitString(input, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wrapped, err := sb.Wrap(input, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := strings.Join(lines, sep); wrapped != want {
+			t.Errorf(`Wrap(%#v) with %#v = %#v; want %#v`, input, sep, wrapped, want)
+		}
+
+		if sep != "" && strings.Count(wrapped, sep) != len(lines)-1 {
+			t.Errorf(`Wrap(%#v) with %#v has %d separators; want %d`, input, sep, strings.Count(wrapped, sep), len(lines)-1)
+		}
+	}
+}
+
+func TestLineSeparator_softBreaks(t *testing.T) {
+	sb := NewSplitBuilder(LineSeparator("\r\n"), SoftBreakSeparator(" "), MarkdownHardBreaks(true))
+
+	_, wrapped, err := sb.WrapAndSplit("one two  \nthree", 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "one  two  \r\nthree"; wrapped != want {
+		t.Errorf(`WrapAndSplit = %#v; want %#v`, wrapped, want)
+	}
+}
@@ -0,0 +1,26 @@
+package wordwrap
+
+const softHyphen = '\u00ad'
+
+// BreakOnSoftHyphen makes the soft hyphens U+00AD of the input break
+// opportunities, as they mark where a word may be hyphenated. A line broken at
+// a soft hyphen ends with a visible "-", and is only broken there when the
+// hyphen fits within its limit. Soft hyphens not broken at are dropped from the
+// output.
+func BreakOnSoftHyphen(brk bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.softHyphens = brk
+		sb.asIs = !brk
+	}
+}
+
+// hyphenFits reports whether a line may break after c, ending width wide,
+// which is only so for a soft hyphen when the hyphen shown in its place fits
+// within limit.
+func (sp *splitter) hyphenFits(c charPos, width, limit uint) bool {
+	if !sp.sb.softHyphens || sp.runeOf(c) != softHyphen {
+		return true
+	}
+
+	return width+sp.sb.charWidth("-") <= limit
+}
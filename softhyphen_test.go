@@ -0,0 +1,41 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakOnSoftHyphen(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"die Donau\u00addampf\u00adschiff\u00adfahrts\u00adgesellschaft", []SplitBuilderOption{BreakOnSoftHyphen(true)},
+			[]string{"die Donau-", "dampfschiff-", "fahrts-", "gesellschaft"}, 12},
+
+		{"die Donau\u00addampf\u00adschiff\u00adfahrts\u00adgesellschaft", nil,
+			[]string{"die ", "Donau\u00addampf", "\u00adschiff\u00adfa", "hrts\u00adgesell", "schaft"}, 12},
+
+		{"Donau\u00addampf", []SplitBuilderOption{BreakOnSoftHyphen(true)},
+			[]string{"Donaudampf"}, 12},
+
+		{"abcde\u00adfgh", []SplitBuilderOption{BreakOnSoftHyphen(true)},
+			[]string{"abcde", "fgh"}, 5},
+
+		{"ab\u00adcdefgh", []SplitBuilderOption{BreakOnSoftHyphen(true)},
+			[]string{"ab-", "cdefg", "h"}, 5},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,669 @@
+package wordwrap
+
+import (
+	"context"
+	"unicode"
+	"unicode/utf8"
+)
+
+// limitFor returns the limit for the line with the given index.
+func (sb *SplitBuilder) limitFor(line int, byteLimit uint) uint {
+	limit := byteLimit
+	if line == 0 && sb.firstLineLimit > 0 {
+		limit = sb.firstLineLimit
+	}
+
+	if sb.limitFunc != nil {
+		limit = sb.limitFunc(line, limit)
+	}
+
+	if sb.hardLimit > 0 && limit > sb.hardLimit {
+		limit = sb.hardLimit
+	}
+
+	if sb.reserveTrailing >= limit {
+		return 0
+	}
+
+	return limit - sb.reserveTrailing
+}
+
+// breakAfter reports whether a line may break after the rune r of size bytes
+// found at byte offset i of the string being split.
+func (sp *splitter) breakAfter(i int, r rune, size int) bool {
+	sb, s := sp.sb, sp.s
+	if !sb.breaksAfter(s, i, r, size) {
+		return false
+	}
+
+	if sp.kept(i) {
+		return false
+	}
+
+	if sb.coalesceBreakRuns && continuesRun(s, i, size, r) {
+		return false
+	}
+
+	if sb.keepNumberUnitTogether && isNumberUnitSpace(s, i, size) {
+		return false
+	}
+
+	if sb.markdownHardBreaks && r == ' ' && isMarkdownHardBreakSpace(s, i) {
+		return false
+	}
+
+	return true
+}
+
+// breaksAfter reports whether the rune r of size bytes found at byte offset i
+// of s is one after which a line may break, before the options keeping text
+// together are applied.
+func (sb *SplitBuilder) breaksAfter(s string, i int, r rune, size int) bool {
+	switch {
+	case sb.honorNBSP && sb.nonBreakingAt(s, i+size, r):
+		return false
+	case sb.breaksBetween(s, i+size, r), sb.hasBreakPriority(r):
+		return true
+	case sb.zwspBreaks && r == zeroWidthSpace, sb.softHyphens && r == softHyphen:
+		return true
+	case sb.hyphenBreaks && hyphenBreak(s, i, r):
+		return true
+	case sb.uax14:
+		return uax14Break(s, i, i+size, r)
+	}
+
+	return sb.cjkBreaks && isCJKBreak(r)
+}
+
+// hardBreak reports whether the rune r found at byte offset i of s forces a
+// line break. The rune itself is dropped from the output.
+func (sb *SplitBuilder) hardBreak(s string, i int, r rune) bool {
+	if r != '\n' {
+		return false
+	}
+
+	return sb.preserveNewlines || sb.onlyReflowOverLong || sb.markdownHardBreaks && isMarkdownHardBreak(s, i)
+}
+
+// isNumberUnitSpace reports whether the space at s[i:i+size] sits between a
+// number and a short unit.
+func isNumberUnitSpace(s string, i, size int) bool {
+	before, _ := utf8.DecodeLastRuneInString(s[:i])
+	if !unicode.IsDigit(before) {
+		return false
+	}
+
+	rest := s[i+size:]
+	if len(rest) > 0 && rest[0] == '%' {
+		rest = rest[1:]
+	} else {
+		n := 0
+		for len(rest) > 0 {
+			r, size := utf8.DecodeRuneInString(rest)
+			if !unicode.IsLetter(r) {
+				break
+			}
+
+			n++
+			rest = rest[size:]
+		}
+
+		if n < 1 || n > 3 {
+			return false
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(rest)
+	return len(rest) == 0 || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// charPos is a character of the input being split.
+type charPos struct {
+	pos, size int
+	width     uint
+	brk       bool
+	// prio is the priority of the break opportunity after the character
+	prio int
+
+	// text replaces the character in the output when replaced is set
+	text     string
+	replaced bool
+}
+
+func (c charPos) end() int {
+	return c.pos + c.size
+}
+
+// runeOf returns the first rune of the character c.
+func (sp *splitter) runeOf(c charPos) rune {
+	r, _ := utf8.DecodeRuneInString(sp.s[c.pos:])
+	return r
+}
+
+// splitter holds the state of a single split of a string.
+type splitter struct {
+	sb        *SplitBuilder
+	s         string
+	byteLimit uint
+
+	// chars holds the characters of the line being built
+	chars []charPos
+	width uint
+	line  int
+
+	// mdOpen holds the Markdown spans open at the start of the working line
+	mdOpen []string
+	// prefix is the prefix detected on the input line being read
+	prefix string
+
+	// opportunities counts the break opportunities seen and used those
+	// which ended a line
+	opportunities, used int
+	// read is the number of bytes of the input read and clusters the
+	// number of characters among them
+	read, clusters int
+
+	// keep holds the byte ranges of phrases kept together
+	keep [][2]int
+
+	// pending holds the last line pushed, while queued
+	pending line
+	queued  bool
+
+	// scanned is set once the whole input has been consumed
+	scanned bool
+	// truncated is set once a line beyond MaxLines was dropped
+	truncated bool
+	// streaming is set when lines are taken before the whole input is split
+	streaming bool
+	// final is set when s holds the rest of the input, such that its end is
+	// that of the input even while streaming
+	final bool
+	// from is the byte offset in s splitting starts at, the text before it
+	// having been split already and only looked back on
+	from int
+	// afterSpace is set when the last character read was whitespace, per
+	// CollapseWhitespace
+	afterSpace bool
+	// continued is set when the line yielded last was broken by wrapping,
+	// such that the next continues the same input line
+	continued bool
+
+	// ctx cancels the split when set
+	ctx context.Context
+	// held holds the lines kept back until the split completes, per
+	// ErrorReturnsPartial
+	held []line
+	// collect is set to collect the break opportunities of each line
+	collect bool
+	// breaks holds the byte offsets in the input at which lines are broken
+	// per Balanced
+	breaks map[int]bool
+	// script is the dominant script of the input detected by AutoScript
+	script string
+
+	yield func(l line) bool
+	done  bool
+}
+
+// line is a line produced by a split.
+type line struct {
+	text string
+	// start and end are the byte offsets in the input of the characters
+	// making up the line
+	start, end int
+	// mdOpen holds the Markdown spans open after the line
+	mdOpen []string
+	// index is the position of the line in the output
+	index int
+	// newline is set when the line ended at a newline of the input which
+	// is not a Markdown hard break
+	newline bool
+	// hard is set when the line ended at a hard break rather than one
+	// inserted by wrapping
+	hard bool
+	// mdBreak is set when the line ended at a Markdown hard break, whose
+	// marker is kept
+	mdBreak bool
+	// trimmed is set when TrimTrailingWhiteSpace removed characters
+	trimmed bool
+	// prefix is prepended to the line per DetectLinePrefix
+	prefix string
+	// opportunities holds the byte offsets in the input of the break
+	// opportunities within the line, when collected
+	opportunities []int
+}
+
+// split feeds each line of s to yield until it returns false.
+func (sb *SplitBuilder) split(s string, byteLimit uint, yield func(l line) bool) error {
+	return sb.newSplitter(s, byteLimit, yield).run()
+}
+
+func (sb *SplitBuilder) newSplitter(s string, byteLimit uint, yield func(l line) bool) *splitter {
+	var detected string
+	if sb.autoScript {
+		var sc script
+		sc, detected = dominantScript(s)
+		sb = sb.forScript(sc)
+	}
+
+	return &splitter{
+		sb:        sb,
+		s:         s,
+		byteLimit: byteLimit,
+		yield:     yield,
+		keep:      findPhrases(s, sb.keepTogether),
+		script:    detected,
+	}
+}
+
+func (sp *splitter) run() error {
+	if max := sp.sb.maxLines; max > 0 && sp.sb.minLines > max {
+		return ErrMinLinesExceedsMaxLines
+	}
+
+	if sp.sb.balanced && (!sp.streaming || sp.final) {
+		sp.breaks = sp.balancedBreaks()
+	}
+
+	err := sp.scan()
+	if err == nil && (!sp.streaming || sp.final) {
+		sp.fill()
+	}
+	sp.flush(err == nil)
+
+	if sp.holds() && err == nil {
+		for _, l := range sp.held {
+			if !sp.yield(l) {
+				break
+			}
+		}
+	}
+
+	return err
+}
+
+func (sp *splitter) scan() error {
+	sb, s := sp.sb, sp.s
+	for i := sp.from; i < len(s) && !sp.done; {
+		if sb.linePrefixes != nil && (i == 0 || s[i-1] == '\n') {
+			if next, ok := sp.stripPrefix(i); ok {
+				i, sp.read = next, next
+				continue
+			}
+		}
+
+		if sb.onlyReflowOverLong && len(sp.chars) == 0 && (i == 0 || s[i-1] == '\n') {
+			if next, ok := sp.passThrough(i); ok {
+				i, sp.read = next, next
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		runeSize := size
+		invalid := r == utf8.RuneError && size == 1
+		if !invalid {
+			size = sb.charSize(s[i:])
+		}
+
+		sp.clusters++
+		sp.read = i + size
+
+		if sp.ctx != nil && sp.clusters%ctxCheckInterval == 0 {
+			if err := sp.ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		if sb.hardBreak(s, i, r) {
+			sp.hardBreak(i, !sb.markdownHardBreaks || !isMarkdownHardBreak(s, i))
+			i += size
+			continue
+		}
+
+		c := charPos{pos: i, size: size, width: sb.charWidth(s[i : i+size]), brk: sp.breakAfter(i, r, runeSize), prio: sb.breakPriority(r)}
+		c.width += sb.markAllowanceIn(s, i, size)
+		if r == '\n' && sb.linePrefixes != nil {
+			if len(sp.chars) == 0 || !sp.joinsNextLine(i) {
+				sp.hardBreak(i, true)
+				i += size
+				continue
+			}
+
+			c.text, c.replaced, c.width = " ", true, sb.charWidth(" ")
+		}
+		if r == '\t' && sb.expandsTabs() {
+			sb.expandTab(&c, sp.width)
+		}
+		if r == softHyphen && sb.softHyphens {
+			c.text, c.replaced, c.width = "", true, 0
+		}
+		if sb.collapseWhitespace {
+			sp.collapse(&c, r)
+		}
+		if invalid {
+			switch sb.invalidUTF8 {
+			case InvalidUTF8Replace:
+				c.text, c.replaced = replacementChar, true
+				c.width = sb.charWidth(replacementChar)
+			case InvalidUTF8Skip:
+				if len(sp.chars) == 0 && (i > sp.from || i == 0) {
+					i += size
+					continue
+				}
+
+				c.text, c.replaced, c.width = "", true, 0
+			case InvalidUTF8Error:
+				return ErrInvalidUTF8
+			}
+		}
+		if c.brk && c.end() < len(s) {
+			sp.opportunities++
+		}
+
+		sp.chars = append(sp.chars, c)
+		sp.width += c.width
+		i += size
+
+		for len(sp.chars) > 0 && sp.width >= sp.limit()+sb.breakNearest && !sp.done {
+			if err := sp.breakLine(); err != nil {
+				return err
+			}
+		}
+
+		if sb.maxWide > 0 && len(sp.chars) > 0 && sp.wideChars() >= sb.maxWide && !sp.done {
+			sp.emit(len(sp.chars))
+		}
+
+		if sp.breaks[i] && len(sp.chars) > 0 && !sp.done {
+			if c.brk {
+				sp.used++
+			}
+			sp.emit(len(sp.chars))
+		}
+
+		if sb.mandatory && r == sb.mandatoryBreak && len(sp.chars) > 0 && !sp.done {
+			sp.emit(len(sp.chars))
+			sp.pending.hard = true
+		}
+	}
+
+	sp.scanned = true
+	for len(sp.chars) > 0 && sp.width > sp.limit() && !sp.done {
+		if err := sp.breakLine(); err != nil {
+			return err
+		}
+	}
+
+	if len(sp.chars) > 0 && !sp.done {
+		sp.emit(len(sp.chars))
+	}
+
+	return nil
+}
+
+func (sp *splitter) limit() uint {
+	limit := sp.sb.limitFor(sp.line, sp.byteLimit)
+
+	reopen := uint(len(mdOpeners(sp.mdOpen))) + sp.sb.measure(sp.sb.prefixFor(sp.line)+sp.prefix)
+	if reopen > limit {
+		return 0
+	}
+
+	return limit - reopen
+}
+
+// breakLine emits the front of a full working line, breaking at the break
+// opportunity that fits with the highest priority, the last among equals, and
+// otherwise breaking the word per the configured strategies.
+func (sp *splitter) breakLine() error {
+	limit := sp.limit()
+
+	var w, brkWidth uint
+	fit, brk := 0, 0
+	open := sp.mdOpen
+	for i, c := range sp.chars {
+		w += c.width
+		if w > limit {
+			break
+		}
+
+		if sp.sb.markdownInlineAware {
+			open = mdToggle(open, sp.s, c.pos)
+			if w+uint(len(mdClosers(open))) > limit {
+				continue
+			}
+		}
+
+		fit = i + 1
+		if c.brk && (brk == 0 || c.prio >= sp.chars[brk-1].prio) && sp.hyphenFits(c, w, limit) {
+			brk, brkWidth = i+1, w
+		}
+	}
+
+	if sp.sb.breakNearest > 0 {
+		brk = sp.nearestBreak(limit, brk, brkWidth)
+	}
+
+	switch {
+	case brk > 0:
+		if sp.chars[brk-1].brk {
+			sp.used++
+		}
+
+		switch c := sp.chars[brk-1]; {
+		case sp.sb.softHyphens && sp.runeOf(c) == softHyphen:
+			sp.emitWith(brk, "-")
+		case sp.sb.zwspBreaks && sp.runeOf(c) == zeroWidthSpace && c.end() < len(sp.s):
+			// the zero-width space broken at is dropped
+			sp.emitShowing(brk, brk-1, "")
+		default:
+			sp.emit(brk)
+		}
+	case fit > 0 && fit == len(sp.chars) && sp.wordEnds(fit):
+		sp.emit(fit)
+	case fit == 0 && sp.sb.oversizeHandler != nil:
+		return sp.handleOversize()
+	case fit == 0 && sp.breakSequence():
+		return nil
+	default:
+		return sp.breakWord(fit)
+	}
+
+	return nil
+}
+
+// hardBreak emits the whole working line, less any carriage return ending it,
+// at the break at byte offset i. newline is set when the break is a plain
+// newline.
+func (sp *splitter) hardBreak(i int, newline bool) {
+	sp.afterSpace = false
+
+	if n := len(sp.chars); n > 0 && sp.s[sp.chars[n-1].pos] == '\r' {
+		sp.width -= sp.chars[n-1].width
+		sp.chars = sp.chars[:n-1]
+	}
+
+	if len(sp.chars) > 0 {
+		sp.emit(len(sp.chars))
+		sp.pending.newline, sp.pending.hard, sp.pending.mdBreak = newline, true, !newline
+		return
+	}
+
+	if sp.sb.preserveNewlines && newline {
+		sp.preserveNewline(i)
+	}
+}
+
+// emit yields the first n characters of the working line as a line.
+func (sp *splitter) emit(n int) {
+	sp.emitWith(n, "")
+}
+
+// emitWith yields the first n characters of the working line followed by
+// suffix as a line.
+func (sp *splitter) emitWith(n int, suffix string) {
+	sp.emitShowing(n, n, suffix)
+}
+
+// emitShowing yields the first n characters of the working line as a line, of
+// which the first shown are output, followed by suffix.
+func (sp *splitter) emitShowing(n, shown int, suffix string) {
+	start, end := sp.chars[0].pos, sp.chars[n-1].end()
+
+	text := sp.text(sp.chars[:shown]) + suffix
+
+	if sp.sb.markdownInlineAware {
+		open := sp.mdOpen
+		for _, c := range sp.chars[:n] {
+			open = mdToggle(open, sp.s, c.pos)
+		}
+
+		text = mdOpeners(sp.mdOpen) + text
+		if end < len(sp.s) {
+			text = mdClose(text, mdClosers(open))
+		}
+
+		sp.mdOpen = open
+	}
+
+	l := line{text: text, start: start, end: end, mdOpen: sp.mdOpen, prefix: sp.prefix}
+	if sp.collect {
+		for _, c := range sp.chars[:n] {
+			if c.brk && c.end() < len(sp.s) {
+				l.opportunities = append(l.opportunities, c.end())
+			}
+		}
+	}
+
+	sp.push(l)
+
+	for _, c := range sp.chars[:n] {
+		sp.width -= c.width
+	}
+
+	sp.chars = sp.chars[:copy(sp.chars, sp.chars[n:])]
+	sp.line++
+
+	if sp.sb.expandsTabs() {
+		sp.reexpandTabs()
+	}
+}
+
+// push queues a line for yielding, yielding the line queued before it. The
+// last line is held back until flush so it may be treated specially.
+func (sp *splitter) push(l line) {
+	if sp.queued && sp.compacts(sp.pending, l) {
+		sp.pending = sp.compact(sp.pending, l)
+		sp.line--
+		return
+	}
+
+	if max := sp.sb.maxLines; max > 0 && uint(sp.line) >= max {
+		// the queued line is the last one within the budget
+		if sp.queued {
+			sp.pending = sp.sb.ellipsize(sp.pending, sp.byteLimit)
+		}
+		sp.flush(true)
+		sp.truncated, sp.done = true, true
+		return
+	}
+
+	l.index = sp.line
+
+	prev, queued := sp.pending, sp.queued
+	sp.pending, sp.queued = l, true
+
+	if queued {
+		sp.out(prev, false)
+	}
+}
+
+// flush yields the queued line, which is the last line if the split completed.
+func (sp *splitter) flush(completed bool) {
+	if !sp.queued || sp.done {
+		return
+	}
+
+	sp.queued = false
+	sp.out(sp.pending, completed)
+}
+
+// out yields a finished line, decorating it per the options.
+func (sp *splitter) out(l line, last bool) {
+	limit := sp.sb.limitFor(l.index, sp.byteLimit)
+	if sp.sb.trimLeadingWhiteSpace && sp.continued {
+		l = sp.sb.trimLineStart(l)
+	}
+	sp.continued = !l.hard
+
+	if sp.sb.justify && !last && !l.hard {
+		if prefix := sp.sb.measure(sp.sb.linePrefixOf(l)); prefix < limit {
+			l = sp.sb.justifyLine(l, limit-prefix)
+		}
+	}
+
+	if prefix := sp.sb.linePrefixOf(l); prefix != "" {
+		l.text = sp.sb.prefixLine(prefix, l.text)
+	}
+
+	switch {
+	case sp.sb.trimVisualEnd && sp.sb.isRTL(l.text):
+		l = sp.sb.trimLineStart(l)
+	case sp.sb.trimTrailingWhiteSpace && !l.mdBreak:
+		l = sp.sb.trimLine(l)
+	}
+
+	if sp.sb.nearLimit != nil {
+		sp.sb.reportNearLimit(l, limit)
+	}
+
+	if sp.sb.align != AlignLeft {
+		l.text = sp.sb.alignLine(l.text, limit)
+	}
+
+	if last && sp.sb.padLastLine {
+		l.text = sp.sb.padRight(l.text, limit)
+	}
+
+	if sp.sb.boxed {
+		l.text = sp.sb.boxLine(l.text, limit)
+	}
+
+	if sp.holds() {
+		sp.held = append(sp.held, l)
+		return
+	}
+
+	if !sp.yield(l) {
+		sp.done = true
+	}
+}
+
+// text returns the output text of the given run of characters.
+func (sp *splitter) text(chars []charPos) string {
+	if len(chars) == 0 {
+		return ""
+	}
+
+	replaced := false
+	for _, c := range chars {
+		replaced = replaced || c.replaced
+	}
+
+	if !replaced {
+		return sp.s[chars[0].pos:chars[len(chars)-1].end()]
+	}
+
+	out := ""
+	for _, c := range chars {
+		if c.replaced {
+			out += c.text
+		} else {
+			out += sp.s[c.pos:c.end()]
+		}
+	}
+
+	return out
+}
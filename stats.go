@@ -0,0 +1,53 @@
+package wordwrap
+
+// Stats holds counters describing a wrap, for metrics and capacity planning.
+type Stats struct {
+	// Bytes is the number of bytes of the input read. It is less than the
+	// length of the input when wrapping stopped early, on an error or at
+	// MaxLines.
+	Bytes int
+	// Clusters is the number of characters among the bytes read, where
+	// runes joined by zero-width joiners count as one.
+	Clusters int
+	// Lines is the number of lines produced.
+	Lines int
+	// Failed is set when wrapping stopped on an error.
+	Failed bool
+	// DetectedScript is the name of the dominant script of the input, as
+	// in unicode.Scripts, when AutoScript is set, and empty otherwise. The
+	// detection is a coarse heuristic over a sample of the letters: it
+	// names a script such as "Latin" or "Han", not a language.
+	DetectedScript string
+}
+
+// WrapWithStats wraps s as WrapString does, returning the wrapped string along
+// with counters describing the wrap. On error the lines produced up to that
+// point are returned.
+func (sb *SplitBuilder) WrapWithStats(s string, byteLimit uint) (string, Stats, error) {
+	b := []byte{}
+	lines := 0
+	sep := ""
+	sp := sb.newSplitter(s, byteLimit, func(l line) bool {
+		b = append(b, sep...)
+		b = append(b, l.text...)
+		sep = sb.separator(l)
+		lines++
+		return true
+	})
+
+	err := sp.run()
+
+	return string(b), Stats{
+		Bytes:          sp.read,
+		Clusters:       sp.clusters,
+		Lines:          lines,
+		Failed:         err != nil,
+		DetectedScript: sp.script,
+	}, err
+}
+
+// WrapWithStats wraps s as WrapString does, returning the wrapped string along
+// with counters describing the wrap.
+func WrapWithStats(s string, byteLimit uint) (string, Stats, error) {
+	return DefaultSplitBuilder.WrapWithStats(s, byteLimit)
+}
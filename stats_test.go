@@ -0,0 +1,53 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestSplitBuilder_WrapWithStats(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  string
+		stats   Stats
+		err     error
+		bytelim uint
+	}{
+		{"", nil,
+			"", Stats{}, nil, 4},
+
+		{"aaa bbb ccc", nil,
+			"aaa \nbbb \nccc", Stats{Bytes: 11, Clusters: 11, Lines: 3}, nil, 4},
+
+		{"👩‍🔬 しし", nil,
+			"👩‍🔬 \nしし", Stats{Bytes: 18, Clusters: 4, Lines: 2}, nil, 12},
+
+		{"ab しcd", nil,
+			"ab\n ", Stats{Bytes: 6, Clusters: 4, Lines: 2, Failed: true}, ErrCharacterTooLarge, 2},
+
+		{"aaa bbb ccc ddd", []SplitBuilderOption{MaxLines(1)},
+			"aaa ", Stats{Bytes: 8, Clusters: 8, Lines: 1}, nil, 4},
+
+		{"ab\ncdef gh", []SplitBuilderOption{OnlyReflowOverLong(true)},
+			"ab\ncdef \ngh", Stats{Bytes: 10, Clusters: 10, Lines: 3}, nil, 5},
+
+		{"go 東京駅", []SplitBuilderOption{AutoScript(true)},
+			"go 東京駅", Stats{Bytes: 12, Clusters: 6, Lines: 1, DetectedScript: "Han"}, nil, 20},
+
+		{"שלום world", []SplitBuilderOption{AutoScript(true)},
+			"שלום world", Stats{Bytes: 14, Clusters: 10, Lines: 1, DetectedScript: "Latin"}, nil, 20},
+
+		{"東京へ行きます", []SplitBuilderOption{AutoScript(true)},
+			"東京へ行きます", Stats{Bytes: 21, Clusters: 7, Lines: 1, DetectedScript: "Hiragana"}, nil, 22},
+
+		{"1 2 3", []SplitBuilderOption{AutoScript(true)},
+			"1 2 3", Stats{Bytes: 5, Clusters: 5, Lines: 1}, nil, 20},
+	}
+
+	for _, test := range tests {
+		actual, stats, err := NewSplitBuilder(test.options...).WrapWithStats(test.input, test.bytelim)
+		if actual != test.output || stats != test.stats || err != test.err {
+			t.Errorf(`WrapWithStats(%#v) = %#v, %+v, %v; want %#v, %+v, %v`, test.input, actual, stats, err, test.output, test.stats, test.err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package wordwrap
+
+import (
+	"errors"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrWordTooLarge is returned when a word does not fit on a line and none of
+// the configured strategies was able to break it.
+var ErrWordTooLarge = errors.New("wordwrap: word exceeds line limit")
+
+// Strategy is a way of breaking a word which is too long to fit on a line.
+type Strategy int
+
+const (
+	// BreakAtLimit breaks the word after the last character which fits.
+	BreakAtLimit Strategy = iota
+	// BreakAtIdentifier breaks the word after the last punctuation character
+	// or between the last lower to upper case change which fits, as found in
+	// paths, URLs and identifiers such as "camelCase" or "snake_case".
+	BreakAtIdentifier
+	// ReturnError stops splitting and returns ErrWordTooLarge.
+	ReturnError
+	// Hyphenate breaks the word at the last hyphenation point given by the
+	// Hyphenator set with WithHyphenator which fits along with a hyphen.
+	Hyphenate
+)
+
+var defaultStrategies = []Strategy{BreakAtLimit}
+
+var defaultHyphenatedStrategies = []Strategy{Hyphenate, BreakAtLimit}
+
+// BreakStrategy sets the strategies tried in order when a word is too long to
+// fit on a line, until one of them is able to break it.
+//
+// The default is BreakAtLimit alone, preceded by Hyphenate when a Hyphenator
+// is set. If every strategy fails, splitting stops with ErrCharacterTooLarge
+// if not even a single character fits on the line, or ErrWordTooLarge
+// otherwise.
+func BreakStrategy(strategies []Strategy) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.strategies = strategies
+		sb.asIs = true
+	}
+}
+
+// BreakLongWords guarantees that a word too long to fit on a line is broken
+// between characters, after the last one which fits, rather than stopping
+// with ErrWordTooLarge, such as a long URL or identifier without spaces. This
+// is the default, and BreakLongWords adds BreakAtLimit to the strategies set
+// with BreakStrategy when they lack it, ahead of ReturnError, as the last
+// resort.
+//
+// A rune is never broken, so a single rune wider than the line still stops
+// splitting with ErrCharacterTooLarge, as does an emoji made of one rune and
+// its modifiers. Emoji sequences joined by zero-width joiners are only broken
+// at their joiners when too wide for the line alone.
+func BreakLongWords(brk bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.breakLongWords = brk
+		sb.asIs = true
+	}
+}
+
+// breakWord breaks a word too long to fit on the working line, of which the
+// first fit characters fit.
+func (sp *splitter) breakWord(fit int) error {
+	for _, st := range sp.sb.activeStrategies() {
+		switch st {
+		case BreakAtLimit:
+			if fit > 0 {
+				sp.emit(fit)
+				return nil
+			}
+		case BreakAtIdentifier:
+			if n := sp.identifierBreak(fit); n > 0 {
+				sp.emit(n)
+				return nil
+			}
+		case ReturnError:
+			return ErrWordTooLarge
+		case Hyphenate:
+			if n := sp.hyphenationBreak(); n > 0 {
+				sp.emitWith(n, "-")
+				return nil
+			}
+		}
+	}
+
+	if fit == 0 {
+		return ErrCharacterTooLarge
+	}
+
+	return ErrWordTooLarge
+}
+
+func (sb *SplitBuilder) activeStrategies() []Strategy {
+	switch {
+	case sb.strategies != nil && sb.breakLongWords:
+		return withBreakAtLimit(sb.strategies)
+	case sb.strategies != nil:
+		return sb.strategies
+	case sb.hyphenator != nil:
+		return defaultHyphenatedStrategies
+	}
+
+	return defaultStrategies
+}
+
+// withBreakAtLimit returns strategies with BreakAtLimit added ahead of
+// ReturnError, or at the end, if they lack it.
+func withBreakAtLimit(strategies []Strategy) []Strategy {
+	n := len(strategies)
+	for i, st := range strategies {
+		if st == BreakAtLimit {
+			return strategies
+		}
+		if st == ReturnError && i < n {
+			n = i
+		}
+	}
+
+	out := make([]Strategy, 0, len(strategies)+1)
+	out = append(out, strategies[:n]...)
+	out = append(out, BreakAtLimit)
+
+	return append(out, strategies[n:]...)
+}
+
+// identifierBreak returns the number of leading characters of the working line
+// ending at the last identifier boundary within the first fit characters, or 0
+// if there is none.
+func (sp *splitter) identifierBreak(fit int) int {
+	for n := fit; n > 0; n-- {
+		prev, _ := utf8.DecodeRuneInString(sp.s[sp.chars[n-1].pos:])
+		if unicode.IsPunct(prev) {
+			return n
+		}
+
+		if n < len(sp.chars) {
+			next, _ := utf8.DecodeRuneInString(sp.s[sp.chars[n].pos:])
+			if unicode.IsLower(prev) && unicode.IsUpper(next) {
+				return n
+			}
+		}
+	}
+
+	return 0
+}
+
+// wordEnds reports whether a word ends with the first n characters of the
+// working line.
+func (sp *splitter) wordEnds(n int) bool {
+	end := sp.chars[n-1].end()
+	if end == len(sp.s) {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(sp.s[end:])
+	return sp.sb.isSpace(r)
+}
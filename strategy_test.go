@@ -0,0 +1,95 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBreakStrategy(t *testing.T) {
+	tests := []struct {
+		input      string
+		strategies []Strategy
+		output     []string
+		err        error
+		bytelim    uint
+	}{
+		{"see example.com/somePath",
+			nil,
+			[]string{"see ", "example.co", "m/somePath"}, nil, 10},
+
+		{"see example.com/somePath",
+			[]Strategy{BreakAtIdentifier, BreakAtLimit},
+			[]string{"see ", "example.", "com/some", "Path"}, nil, 10},
+
+		{"see abcdefghijkl",
+			[]Strategy{BreakAtIdentifier, BreakAtLimit},
+			[]string{"see ", "abcdefghij", "kl"}, nil, 10},
+
+		{"see abcdefghijkl",
+			[]Strategy{BreakAtIdentifier, ReturnError},
+			[]string{"see "}, ErrWordTooLarge, 10},
+
+		{"abcd efgh",
+			[]Strategy{ReturnError},
+			[]string{"abcd", " ", "efgh"}, nil, 4},
+
+		{"abし",
+			[]Strategy{BreakAtLimit},
+			[]string{"ab"}, ErrCharacterTooLarge, 2},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(BreakStrategy(test.strategies)).SplitString(test.input, test.bytelim)
+		if err != test.err {
+			t.Errorf(`SplitString(%#v) error = %v; want %v`, test.input, err, test.err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestBreakLongWords(t *testing.T) {
+	long := strings.Repeat("abcdefghij", 10)
+
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{long, []SplitBuilderOption{BreakLongWords(true)},
+			[]string{long[:20], long[20:40], long[40:60], long[60:80], long[80:]}, 20},
+
+		{long[:30], []SplitBuilderOption{BreakStrategy([]Strategy{ReturnError}), BreakLongWords(true)},
+			[]string{long[:20], long[20:30]}, 20},
+
+		{"see example.com/a/long/path", []SplitBuilderOption{BreakStrategy([]Strategy{BreakAtIdentifier, ReturnError}), BreakLongWords(true)},
+			[]string{"see ", "example.com/a/", "long/path"}, 15},
+
+		{"see examplecomalongpath", []SplitBuilderOption{BreakStrategy([]Strategy{BreakAtIdentifier, ReturnError}), BreakLongWords(true)},
+			[]string{"see ", "examplecomalong", "path"}, 15},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestBreakLongWords_strategies(t *testing.T) {
+	sb := NewSplitBuilder(BreakStrategy([]Strategy{BreakAtIdentifier, ReturnError}), BreakLongWords(true))
+
+	want := []Strategy{BreakAtIdentifier, BreakAtLimit, ReturnError}
+	if actual := sb.activeStrategies(); !reflect.DeepEqual(actual, want) {
+		t.Errorf(`activeStrategies = %v; want %v`, actual, want)
+	}
+}
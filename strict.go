@@ -0,0 +1,58 @@
+package wordwrap
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// ErrInvalidStrictProtocolMode is returned by StrictProtocolMode when the
+// delimiter could never fit within the byte limit.
+var ErrInvalidStrictProtocolMode = errors.New("wordwrap: invalid strict protocol mode")
+
+// StrictProtocolMode configures a SplitBuilder for protocols whose lines may
+// only be broken at an explicit delimiter and never exceed byteLimit bytes:
+//
+//   - every delimiter ends a line, and is kept at the end of the line
+//   - whitespace is not a break opportunity, nor is anything else
+//   - lines are measured in bytes and never exceed byteLimit, even when a
+//     larger limit is given when splitting
+//   - a run without a delimiter longer than byteLimit is broken at the limit
+//   - no content is added, removed or rewritten, as with Lossless
+//
+// Options applied after StrictProtocolMode are not checked and may break these
+// semantics. ErrInvalidStrictProtocolMode is returned if the delimiter is not a
+// valid rune or is longer than byteLimit.
+func StrictProtocolMode(delimiter rune, byteLimit uint) (SplitBuilderOption, error) {
+	if !utf8.ValidRune(delimiter) || uint(utf8.RuneLen(delimiter)) > byteLimit {
+		return nil, ErrInvalidStrictProtocolMode
+	}
+
+	return func(sb *SplitBuilder) {
+		Lossless(true)(sb)
+
+		sb.keepNumberUnitTogether = false
+		sb.strategies = []Strategy{BreakAtLimit}
+		sb.breakNearest = 0
+		sb.keepTogether = nil
+		sb.widthMode = MeasureBytes
+		sb.categoryWeights, sb.categoryRules = nil, nil
+		sb.zeroAdvance = nil
+		sb.transliterated = false
+		sb.ignoreANSI = false
+		sb.markAllowance = 0
+		sb.maxWide = 0
+		sb.whitespaceFunc = func(r rune) bool { return false }
+		sb.breakFunc = nil
+		sb.breakPriorities = nil
+		sb.hyphenBreaks = false
+		sb.uax14 = false
+		sb.autoScript, sb.cjkBreaks = false, false
+		sb.balanced = false
+
+		sb.mandatory, sb.mandatoryBreak = true, delimiter
+		sb.hardLimit = byteLimit
+
+		// the delimiter breaks lines which fit, overriding Lossless
+		sb.asIs = false
+	}, nil
+}
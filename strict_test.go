@@ -0,0 +1,121 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStrictProtocolMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"CMD a b;CMD c;", nil,
+			[]string{"CMD a b;", "CMD c;"}, nil, 80},
+
+		{"CMD a b;CMD c;", nil,
+			[]string{"CMD a b;", "CMD c;"}, nil, 8},
+
+		{"CMD aaaa bbbb;CMD c", nil,
+			[]string{"CMD aaaa", " bbbb;", "CMD c"}, nil, 80},
+
+		{"AB;;CD", nil,
+			[]string{"AB;", ";", "CD"}, nil, 80},
+
+		{"**a**  \n;b", []SplitBuilderOption{MarkdownInlineAware(true), MarkdownHardBreaks(true)},
+			[]string{"**a**  \n", ";", "b"}, nil, 80},
+
+		{"abcdefg✓;", nil,
+			[]string{"abcdefg", "✓;"}, nil, 8},
+	}
+
+	for _, test := range tests {
+		strict, err := StrictProtocolMode(';', 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sb := NewSplitBuilder(append(test.options, strict)...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if !reflect.DeepEqual(actual, test.output) || err != test.err {
+			t.Errorf(`SplitString(%#v) = %#v, %v; want %#v, %v`, test.input, actual, err, test.output, test.err)
+		}
+	}
+}
+
+func TestStrictProtocolMode_invalid(t *testing.T) {
+	tests := []struct {
+		delimiter rune
+		bytelim   uint
+	}{
+		{';', 0},
+		{'✓', 2},
+		{-1, 8},
+	}
+
+	for _, test := range tests {
+		if _, err := StrictProtocolMode(test.delimiter, test.bytelim); err != ErrInvalidStrictProtocolMode {
+			t.Errorf(`StrictProtocolMode(%q, %d) error = %v; want %v`, test.delimiter, test.bytelim, err, ErrInvalidStrictProtocolMode)
+		}
+	}
+}
+
+func TestStrictProtocolMode_overridesBreaks(t *testing.T) {
+	strict, err := StrictProtocolMode('|', 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := []string{
+		"ééééé|",
+		"中文中文中文|",
+		"\x1b[31m\x1b[0mxyz|",
+		"abcd ef-gh|",
+		"ab/cd.ef gh|",
+		"long-term plan|",
+		"ab\u0301\u0302cdef|",
+		"a/bcdefgh|",
+		"a bcdefgh|",
+		"文ab文文文文|",
+	}
+
+	options := map[string]SplitBuilderOption{
+		"MeasureAsTransliterated": MeasureAsTransliterated(true),
+		"IgnoreANSI":              IgnoreANSI(true),
+		"BreakOnHyphens":          BreakOnHyphens(true),
+		"UseUAX14":                UseUAX14(true),
+		"BreakAfter":              BreakAfter(func(prev, next rune) bool { return prev == ' ' }),
+		"BreakPriorities":         BreakPriorities(map[rune]int{'/': 1, '.': 2}),
+		"AutoScript":              AutoScript(true),
+		"MarkOverflowAllowance":   MarkOverflowAllowance(2),
+		"MaxWideCharsPerLine":     MaxWideCharsPerLine(1),
+		"Balanced":                Balanced(true),
+	}
+
+	for _, input := range inputs {
+		want, err := NewSplitBuilder(strict).SplitString(input, 80)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, input, err)
+		}
+
+		for name, option := range options {
+			actual, err := NewSplitBuilder(option, strict).SplitString(input, 80)
+			if err != nil {
+				t.Fatalf(`SplitString(%#v) after %s unexpected error: %s`, input, name, err)
+			}
+
+			if !reflect.DeepEqual(actual, want) {
+				t.Errorf(`SplitString(%#v) after %s = %#v; want %#v`, input, name, actual, want)
+			}
+
+			for _, line := range actual {
+				if len(line) > 6 {
+					t.Errorf(`SplitString(%#v) after %s line %#v exceeds 6 bytes`, input, name, line)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package wordwrap
+
+// ExpandTabs replaces each tab with spaces up to the next tab stop, every
+// tabWidth columns from the start of the line, so lines holding tabs fit
+// their limit as displayed rather than counting each tab as one character.
+// The spaces are measured as any other in the active WidthMode. A tabWidth of
+// 0 disables the expansion, keeping tabs as is.
+//
+// Tab stops are counted from the start of the content of the line, after any
+// prefix, and a tab carried onto a new line by a break is expanded again from
+// its place there. Each line kept by PreserveNewlines or MarkdownHardBreaks
+// counts its tab stops afresh.
+func ExpandTabs(tabWidth uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.tabWidth = tabWidth
+		sb.asIs = tabWidth == 0
+	}
+}
+
+// expandsTabs reports whether tabs are expanded, which they are not when
+// collapsed with other whitespace.
+func (sb *SplitBuilder) expandsTabs() bool {
+	return sb.tabWidth > 0 && !sb.collapseWhitespace
+}
+
+// expandTab replaces the tab c, found at column of its line, with the spaces
+// up to the next tab stop.
+func (sb *SplitBuilder) expandTab(c *charPos, column uint) {
+	c.text = sb.padRight("", sb.tabWidth-column%sb.tabWidth)
+	c.replaced, c.width = true, sb.measure(c.text)
+}
+
+// reexpandTabs expands the tabs of the working line again from their columns
+// on it, once a break has moved them onto a new line.
+func (sp *splitter) reexpandTabs() {
+	var column uint
+	for i := range sp.chars {
+		c := &sp.chars[i]
+		if c.replaced && sp.s[c.pos] == '\t' {
+			sp.width -= c.width
+			sp.sb.expandTab(c, column)
+			sp.width += c.width
+		}
+
+		column += c.width
+	}
+}
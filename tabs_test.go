@@ -0,0 +1,56 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandTabs(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"\tindented text", []SplitBuilderOption{ExpandTabs(8)},
+			[]string{"        ", "indented ", "text"}, 12},
+
+		{"\tab cd", []SplitBuilderOption{ExpandTabs(4)},
+			[]string{"    ab ", "cd"}, 8},
+
+		{"a\tb\tc", []SplitBuilderOption{ExpandTabs(4)},
+			[]string{"a   b   c"}, 20},
+
+		{"ab cd\tef", []SplitBuilderOption{ExpandTabs(4)},
+			[]string{"ab ", "cd  ef"}, 7},
+
+		{"ab\tcd\nef\tgh", []SplitBuilderOption{ExpandTabs(4), PreserveNewlines(true)},
+			[]string{"ab  cd", "ef  gh"}, 10},
+
+		{"a\tb\nccc\td\n\tx\ty\nab\tc", []SplitBuilderOption{ExpandTabs(4), PreserveNewlines(true)},
+			[]string{"a   b", "ccc d", "    x   y", "ab  c"}, 20},
+
+		{"a\tb\nccc\td\n\tx", []SplitBuilderOption{ExpandTabs(4), PreserveNewlines(true), LinePrefix("> ")},
+			[]string{"> a   b", "> ccc d", ">     x"}, 20},
+
+		{"ab  \nc\td", []SplitBuilderOption{ExpandTabs(4), MarkdownHardBreaks(true)},
+			[]string{"ab  ", "c   d"}, 20},
+
+		{"\tab", []SplitBuilderOption{ExpandTabs(4), MeasureBy(MeasureDisplayWidth)},
+			[]string{"    ab"}, 10},
+
+		{"\tindented text", nil,
+			[]string{"\tindented ", "text"}, 12},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package wordwrap
+
+// WrapTokens packs already tokenized text into lines, joining the tokens of a
+// line with sep and starting a new line when the next token and its separator
+// would exceed the limit. The tokens are not scanned for break opportunities.
+//
+// Tokens and separators are measured in the active WidthMode. A token wider
+// than the limit on its own is broken per the BreakStrategy, and its last
+// piece may be followed by further tokens on the same line.
+func (sb *SplitBuilder) WrapTokens(tokens []string, byteLimit uint, sep string) ([]string, error) {
+	lines := []string{}
+
+	sepWidth := sb.measure(sep)
+
+	var (
+		cur   string
+		width uint
+		open  bool
+	)
+	for _, tok := range tokens {
+		limit := sb.limitFor(len(lines), byteLimit)
+		w := sb.measure(tok)
+		if open && width+sepWidth+w <= limit {
+			cur += sep + tok
+			width += sepWidth + w
+			continue
+		}
+
+		if open {
+			lines = append(lines, cur)
+			limit = sb.limitFor(len(lines), byteLimit)
+		}
+
+		cur, width, open = tok, w, true
+		if w <= limit {
+			continue
+		}
+
+		pieces, err := sb.SplitString(tok, limit)
+		if err != nil {
+			return append(lines, pieces...), err
+		}
+
+		n := len(pieces) - 1
+		lines = append(lines, pieces[:n]...)
+		cur, width = pieces[n], sb.measure(pieces[n])
+	}
+
+	if open {
+		lines = append(lines, cur)
+	}
+
+	return lines, nil
+}
+
+// WrapTokens packs already tokenized text into lines of at most byteLimit
+// bytes, joining the tokens of a line with sep.
+func WrapTokens(tokens []string, byteLimit uint, sep string) ([]string, error) {
+	return DefaultSplitBuilder.WrapTokens(tokens, byteLimit, sep)
+}
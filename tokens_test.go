@@ -0,0 +1,45 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_WrapTokens(t *testing.T) {
+	tests := []struct {
+		tokens  []string
+		sep     string
+		options []SplitBuilderOption
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{[]string{"aaa", "bbb", "ccc"}, " ", nil,
+			[]string{"aaa bbb", "ccc"}, nil, 7},
+
+		{[]string{"a b", "c d", "e f"}, ", ", nil,
+			[]string{"a b, c d", "e f"}, nil, 8},
+
+		{[]string{"aa", "bbbbbbbbbb", "cc"}, " ", nil,
+			[]string{"aa", "bbbb", "bbbb", "bb", "cc"}, nil, 4},
+
+		{[]string{"bbbbbb", "c"}, " ", nil,
+			[]string{"bbbb", "bb c"}, nil, 4},
+
+		{[]string{"aa", "bbbbbbbbbb", "cc"}, " ", []SplitBuilderOption{BreakStrategy([]Strategy{ReturnError})},
+			[]string{"aa"}, ErrWordTooLarge, 4},
+
+		{[]string{"aa", "bb", "cc"}, " ", []SplitBuilderOption{FirstLineLimit(2)},
+			[]string{"aa", "bb cc"}, nil, 5},
+
+		{[]string{}, " ", nil,
+			[]string{}, nil, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).WrapTokens(test.tokens, test.bytelim, test.sep)
+		if !reflect.DeepEqual(actual, test.output) || err != test.err {
+			t.Errorf(`WrapTokens(%#v) = %#v, %v; want %#v, %v`, test.tokens, actual, err, test.output, test.err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// MeasureAsTransliterated measures each character by the approximate length
+// of its ASCII transliteration, while emitting the original, such that lines
+// still fit once a downstream system folds them to ASCII. It takes precedence
+// over CategoryWeights and MeasureBy.
+//
+// This is an approximation: combining marks measure 0, ligatures and letters
+// commonly spelled out such as "ß" or "æ" measure 2, and every other rune
+// measures 1, including those of scripts a transliterator might drop or spell
+// out at length. Folding uses a built-in table rather than golang.org/x/text
+// to keep the package free of dependencies.
+func MeasureAsTransliterated(transliterated bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.transliterated = transliterated
+		sb.asIs = true
+	}
+}
+
+// transliteratedWidth returns the approximate length of the ASCII
+// transliteration of c.
+func transliteratedWidth(c string) uint {
+	var w uint
+	for i := 0; i < len(c); {
+		r, size := utf8.DecodeRuneInString(c[i:])
+		i += size
+
+		switch {
+		case r < utf8.RuneSelf:
+			w++
+		case unicode.In(r, unicode.Mn, unicode.Me), r == zeroWidthJoiner:
+		default:
+			w += foldedLength(r)
+		}
+	}
+
+	return w
+}
+
+// foldedLength returns the number of ASCII letters r is commonly spelled with.
+func foldedLength(r rune) uint {
+	switch r {
+	case 'ß', 'Æ', 'æ', 'Œ', 'œ', 'Þ', 'þ', 'Ĳ', 'ĳ', 'ﬀ', 'ﬁ', 'ﬂ', 'ﬆ':
+		return 2
+	case 'ﬃ', 'ﬄ':
+		return 3
+	}
+
+	return 1
+}
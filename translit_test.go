@@ -0,0 +1,32 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMeasureAsTransliterated(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"café crème brûlée", []string{"café ", "crème ", "brûlée"}, 7},
+		{"café crème", []string{"café ", "crème"}, 6},
+		{"straße straße", []string{"straße ", "straße"}, 8},
+		{"Ærø æble", []string{"Ærø ", "æble"}, 6},
+		{"e\u0301te\u0301 ab", []string{"e\u0301te\u0301 ", "ab"}, 5},
+	}
+
+	sb := NewSplitBuilder(MeasureAsTransliterated(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// TrimTrailingWhiteSpace removes the whitespace ending each line, such as the
+// space a line was broken after. Lines passed through by OnlyReflowOverLong
+// are trimmed as well, while lines ending at a hard break of
+// MarkdownHardBreaks keep the spaces of its marker. SplitKeyed reports which
+// lines were trimmed.
+func TrimTrailingWhiteSpace(trim bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.trimTrailingWhiteSpace = trim
+		sb.asIs = !trim
+	}
+}
+
+// TrimLeadingWhiteSpace removes the whitespace starting each line which
+// continues an input line broken by wrapping, such as a run of spaces carried
+// over a break. The indentation of lines starting an input line, whether the
+// first line or one following a break kept per PreserveNewlines, is kept.
+// A line of whitespace alone is left empty, as with TrimTrailingWhiteSpace.
+// Prefixes of LinePrefix and DetectLinePrefix are added after trimming.
+// SplitKeyed reports which lines were trimmed.
+func TrimLeadingWhiteSpace(trim bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.trimLeadingWhiteSpace = trim
+		sb.asIs = true
+	}
+}
+
+// TrimSet sets the characters removed by TrimTrailingWhiteSpace,
+// TrimLeadingWhiteSpace and TrimVisualEnd, overriding the default of all
+// Unicode whitespace, or that of WhitespaceFunc if set.
+func TrimSet(set string) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.trimSetOn, sb.trimSet = true, set
+		sb.asIs = true
+	}
+}
+
+// trims reports whether r is removed when trimming lines.
+func (sb *SplitBuilder) trims(r rune) bool {
+	if !sb.trimSetOn {
+		return sb.isSpace(r)
+	}
+
+	for _, t := range sb.trimSet {
+		if r == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trimLineStart removes the leading whitespace of l.
+func (sb *SplitBuilder) trimLineStart(l line) line {
+	start := 0
+	for start < len(l.text) {
+		r, size := utf8.DecodeRuneInString(l.text[start:])
+		if !sb.trims(r) {
+			break
+		}
+		start += size
+	}
+
+	if start > 0 {
+		l.text, l.trimmed = l.text[start:], true
+	}
+
+	return l
+}
+
+// trimLine removes the trailing whitespace of l.
+func (sb *SplitBuilder) trimLine(l line) line {
+	end := len(l.text)
+	for end > 0 {
+		r, size := utf8.DecodeLastRuneInString(l.text[:end])
+		if !sb.trims(r) {
+			break
+		}
+		end -= size
+	}
+
+	if end < len(l.text) {
+		l.text, l.trimmed = l.text[:end], true
+	}
+
+	return l
+}
@@ -0,0 +1,133 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrimTrailingWhiteSpace(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		trimmed []bool
+		bytelim uint
+	}{
+		{"aaa bbb ccc", []string{"aaa", "bbb", "ccc"}, []bool{true, true, false}, 4},
+		{"aaa  bbb ", []string{"aaa", "", "bbb"}, []bool{true, true, true}, 4},
+		{"abcdefgh", []string{"abcd", "efgh"}, []bool{false, false}, 4},
+	}
+
+	sb := NewSplitBuilder(TrimTrailingWhiteSpace(true))
+	for _, test := range tests {
+		lines, err := sb.SplitKeyed(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitKeyed(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		actual, trimmed := []string{}, []bool{}
+		for _, l := range lines {
+			actual = append(actual, l.Text)
+			trimmed = append(trimmed, l.Trimmed)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) || !reflect.DeepEqual(trimmed, test.trimmed) {
+			t.Errorf(`SplitKeyed(%#v) = %#v, %#v; want %#v, %#v`, test.input, actual, trimmed, test.output, test.trimmed)
+		}
+	}
+}
+
+func TestTrimSet(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"日本語\u3000テキスト", nil,
+			[]string{"日本語", "テキスト"}, 12},
+
+		{"a\u00a0b\u2009c d", nil,
+			[]string{"a", "b", "c d"}, 4},
+
+		{"日本語\u3000テキスト", []SplitBuilderOption{TrimSet(" \t")},
+			[]string{"日本語\u3000", "テキスト"}, 12},
+
+		{"one... two... three", []SplitBuilderOption{TrimSet(". ")},
+			[]string{"one", "two", "three"}, 8},
+
+		{"aaa bbb", []SplitBuilderOption{TrimSet("")},
+			[]string{"aaa ", "bbb"}, 4},
+	}
+
+	for _, test := range tests {
+		options := append([]SplitBuilderOption{TrimTrailingWhiteSpace(true)}, test.options...)
+		actual, err := NewSplitBuilder(options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestTrimLeadingWhiteSpace(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"Hello world this is a test", nil,
+			[]string{"Hello ", "world ", "this is a ", "test"}, 10},
+
+		{"aaa   bbb", nil,
+			[]string{"aaa ", "", "bbb"}, 4},
+
+		{"  indented text here", nil,
+			[]string{"  indented ", "text here"}, 12},
+
+		{"first line\n  indented line", []SplitBuilderOption{PreserveNewlines(true)},
+			[]string{"first line", "  indented ", "line"}, 12},
+
+		{"aaa   bbb", []SplitBuilderOption{LinePrefix("> ")},
+			[]string{"> aaa ", ">", "> bbb"}, 6},
+	}
+
+	for _, test := range tests {
+		options := append([]SplitBuilderOption{TrimLeadingWhiteSpace(true)}, test.options...)
+		actual, err := NewSplitBuilder(options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+	}
+}
+
+func TestTrimTrailingWhiteSpace_markdownHardBreaks(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"roses are red  \nviolets are blue", []string{"roses are red  ", "violets are blue"}, 40},
+		{"roses are red  \r\nviolets  are blue ", []string{"roses are", "red  ", "violets", "are blue"}, 10},
+	}
+
+	sb := NewSplitBuilder(MarkdownHardBreaks(true), TrimTrailingWhiteSpace(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
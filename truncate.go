@@ -0,0 +1,42 @@
+package wordwrap
+
+// Truncate shortens s to fit within byteLimit, as for a table cell or a label
+// which must stay on one line. s is returned as is if it fits, and otherwise
+// the longest run of whole characters starting it which leaves room for
+// ellipsis, followed by ellipsis. Widths are measured in the active WidthMode.
+//
+// ErrCharacterTooLarge is returned if s does not fit and ellipsis alone is
+// wider than byteLimit.
+func (sb *SplitBuilder) Truncate(s string, byteLimit uint, ellipsis string) (string, error) {
+	if sb.measure(s) <= byteLimit {
+		return s, nil
+	}
+
+	width := sb.measure(ellipsis)
+	if width > byteLimit {
+		return "", ErrCharacterTooLarge
+	}
+
+	return sb.prefixWithin(s, byteLimit-width) + ellipsis, nil
+}
+
+// Truncate shortens s to fit within byteLimit bytes, ending it with ellipsis
+// if anything was cut.
+func Truncate(s string, byteLimit uint, ellipsis string) (string, error) {
+	return DefaultSplitBuilder.Truncate(s, byteLimit, ellipsis)
+}
+
+// prefixWithin returns the longest run of whole characters starting s which
+// is at most limit wide.
+func (sb *SplitBuilder) prefixWithin(s string, limit uint) string {
+	var width uint
+	for i := 0; i < len(s); {
+		size := sb.charSize(s[i:])
+		if width += sb.charWidth(s[i : i+size]); width > limit {
+			return s[:i]
+		}
+		i += size
+	}
+
+	return s
+}
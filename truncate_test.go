@@ -0,0 +1,43 @@
+package wordwrap
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		input    string
+		options  []SplitBuilderOption
+		ellipsis string
+		output   string
+		bytelim  uint
+	}{
+		{"short", nil, "...", "short", 10},
+		{"exactly10!", nil, "...", "exactly10!", 10},
+		{"a longer label", nil, "...", "a longe...", 10},
+		{"a longer label", nil, "", "a longer l", 10},
+		{"日本語のラベル", nil, "…", "日本語…", 12},
+		{"👩‍🔬👩‍🔬👩‍🔬", nil, ".", "👩‍🔬.", 12},
+		{"éééé", []SplitBuilderOption{MeasureBy(MeasureRunes)}, "…", "éé…", 3},
+		{"日本語のラベル", []SplitBuilderOption{MeasureBy(MeasureDisplayWidth)}, "…", "日本語…", 7},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).Truncate(test.input, test.bytelim, test.ellipsis)
+		if err != nil {
+			t.Fatalf(`Truncate(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if actual != test.output {
+			t.Errorf(`Truncate(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestTruncate_ellipsisTooLarge(t *testing.T) {
+	if _, err := Truncate("a longer label", 2, "..."); err != ErrCharacterTooLarge {
+		t.Errorf(`Truncate error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if actual, err := Truncate("ab", 2, "..."); err != nil || actual != "ab" {
+		t.Errorf(`Truncate("ab") = %#v, %v; want "ab", nil`, actual, err)
+	}
+}
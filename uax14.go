@@ -0,0 +1,85 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// UseUAX14 adds the break opportunities of the Unicode Line Breaking
+// Algorithm, UAX #14, to those at whitespace, for text mixing CJK and Latin
+// scripts or hyphenated compounds. Lines may then also break:
+//
+//   - after a hyphen or dash joining two words, as in "long-term", but not
+//     before a number, as in "-5"
+//   - before and after a CJK ideograph, kana or syllable, but not before
+//     closing punctuation, such as "。" or "」", a small kana or an iteration
+//     mark, nor after opening punctuation, such as "「"
+//   - before and after an em dash
+//
+// This is a subset of the algorithm covering the common cases, implemented on
+// the Unicode tables of the standard library rather than the full line break
+// property. It supersedes the CJK breaks of AutoScript.
+func UseUAX14(use bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.uax14 = use
+		sb.asIs = true
+	}
+}
+
+// uax14Break reports whether UAX #14 allows a break after the rune r found at
+// byte offset i of s and followed at byte offset following.
+func uax14Break(s string, i, following int, r rune) bool {
+	next, _ := utf8.DecodeRuneInString(s[following:])
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+
+	switch {
+	case isOpeningPunct(r) || noBreakBefore(next):
+		return false
+	case r == '—' || next == '—':
+		return true
+	case isHyphen(r):
+		return (unicode.IsLetter(prev) || unicode.IsDigit(prev)) && unicode.IsLetter(next)
+	case isIdeographic(r):
+		return true
+	case isIdeographic(next):
+		return !unicode.IsSpace(r)
+	}
+
+	return false
+}
+
+// isHyphen reports whether r is a hyphen or dash after which a word may
+// break.
+func isHyphen(r rune) bool {
+	return r == '-' || r == '‐' || r == '‒' || r == '–'
+}
+
+// isIdeographic reports whether r is a CJK ideograph, kana, syllable or
+// punctuation, around which lines may break.
+func isIdeographic(r rune) bool {
+	return isCJKBreak(r) && !noBreakBefore(r) && !isOpeningPunct(r)
+}
+
+// isOpeningPunct reports whether r opens a span, such that no break may
+// follow it.
+func isOpeningPunct(r rune) bool {
+	return unicode.In(r, unicode.Ps, unicode.Pi)
+}
+
+// noBreakBefore reports whether r may not start a line: closing punctuation,
+// exclamation and separators, small kana and iteration marks.
+func noBreakBefore(r rune) bool {
+	if unicode.In(r, unicode.Pe, unicode.Pf) {
+		return true
+	}
+
+	switch r {
+	case '!', '?', ',', '.', ':', ';',
+		'、', '。', '，', '．', '：', '；', '！', '？', '・', 'ー', '々', 'ゝ', 'ゞ', 'ヽ', 'ヾ',
+		'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ', 'っ', 'ゃ', 'ゅ', 'ょ', 'ゎ',
+		'ァ', 'ィ', 'ゥ', 'ェ', 'ォ', 'ッ', 'ャ', 'ュ', 'ョ', 'ヮ', 'ヵ', 'ヶ':
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUseUAX14(t *testing.T) {
+	tests := []struct {
+		input   string
+		spaces  []string
+		uax14   []string
+		bytelim uint
+	}{
+		{"a state-of-the-art design",
+			[]string{"a ", "state-of-t", "he-art ", "design"},
+			[]string{"a state-", "of-the-", "art ", "design"}, 10},
+
+		{"costs -5 to -10 points",
+			[]string{"costs -5 ", "to -10 ", "points"},
+			[]string{"costs -5 ", "to -10 ", "points"}, 10},
+
+		{"日本語の文章です。次の文。",
+			[]string{"日本語の文章", "です。次の文", "。"},
+			[]string{"日本語の文章", "です。次の", "文。"}, 18},
+
+		{"彼は「引用」と言った",
+			[]string{"彼は「", "引用」", "と言っ", "た"},
+			[]string{"彼は", "「引", "用」と", "言った"}, 9},
+
+		{"mixed漢字text",
+			[]string{"mixed漢字t", "ext"},
+			[]string{"mixed漢字", "text"}, 12},
+
+		{"wait—what",
+			[]string{"wait—w", "hat"},
+			[]string{"wait—", "what"}, 8},
+	}
+
+	for _, test := range tests {
+		spaces, err := NewSplitBuilder().SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(spaces, test.spaces) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, spaces, test.spaces)
+		}
+
+		uax14, err := NewSplitBuilder(UseUAX14(true)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) with UAX #14 unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(uax14, test.uax14) {
+			t.Errorf(`SplitString(%#v) with UAX #14 = %#v; want %#v`, test.input, uax14, test.uax14)
+		}
+	}
+}
+
+func TestUseUAX14_invalidUTF8(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"long-term \xff plan", nil,
+			[]string{"long-term ", "� plan"}, 11},
+
+		{"ab-\xffcd-ef", []SplitBuilderOption{InvalidUTF8Policy(InvalidUTF8Keep)},
+			[]string{"ab-\xffcd-", "ef"}, 8},
+
+		{"12 \xff  kg-\xff cd", []SplitBuilderOption{InvalidUTF8Policy(InvalidUTF8Keep), CoalesceBreakRuns(true), KeepNumberUnitTogether(true)},
+			[]string{"12 \xff  ", "kg-\xff ", "cd"}, 6},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(append(test.options, UseUAX14(true))...)
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
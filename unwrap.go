@@ -0,0 +1,62 @@
+package wordwrap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Unwrap collapses multi-line s onto a single line for display in a log
+// preview or a table cell, joining its lines with sep, such as " | " or " ⏎ ".
+//
+// Each line has its leading and trailing whitespace removed and its interior
+// runs of whitespace collapsed to a single space. Lines left empty, such as
+// those separating paragraphs, are dropped rather than joined. Lines end at a
+// \n, along with any \r before it. Only whitespace is removed or replaced, so
+// characters and the sequences joining them are never altered.
+func Unwrap(s, sep string) string {
+	b := make([]byte, 0, len(s))
+
+	lines := 0
+	for start := 0; start <= len(s); {
+		end := start
+		for end < len(s) && s[end] != '\n' {
+			end++
+		}
+
+		if l := collapseSpace(s[start:end]); l != "" {
+			if lines > 0 {
+				b = append(b, sep...)
+			}
+			b = append(b, l...)
+			lines++
+		}
+
+		start = end + 1
+	}
+
+	return string(b)
+}
+
+// collapseSpace trims the whitespace surrounding s and collapses its interior
+// runs of whitespace to a single space.
+func collapseSpace(s string) string {
+	b := make([]byte, 0, len(s))
+
+	space := false
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			space = len(b) > 0
+		} else {
+			if space {
+				b = append(b, ' ')
+				space = false
+			}
+			b = append(b, s[i:i+size]...)
+		}
+
+		i += size
+	}
+
+	return string(b)
+}
@@ -0,0 +1,27 @@
+package wordwrap
+
+import (
+	"testing"
+)
+
+func TestUnwrap(t *testing.T) {
+	tests := []struct {
+		input  string
+		sep    string
+		output string
+	}{
+		{"", " | ", ""},
+		{"single line", " | ", "single line"},
+		{"first line\nsecond line", " | ", "first line | second line"},
+		{"  indented\t\ttext  \r\n  more   text \n", " ⏎ ", "indented text ⏎ more text"},
+		{"para one\n\n\npara two", " | ", "para one | para two"},
+		{"👩‍🔬\n👨‍👩‍👧", "|", "👩‍🔬|👨‍👩‍👧"},
+		{"a  b\nc", "/", "a b/c"},
+	}
+
+	for _, test := range tests {
+		if actual := Unwrap(test.input, test.sep); actual != test.output {
+			t.Errorf(`Unwrap(%#v, %#v) = %#v; want %#v`, test.input, test.sep, actual, test.output)
+		}
+	}
+}
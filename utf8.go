@@ -0,0 +1,37 @@
+package wordwrap
+
+import "errors"
+
+// ErrInvalidUTF8 is returned when the input holds invalid UTF-8 and
+// InvalidUTF8Policy(InvalidUTF8Error) is set.
+var ErrInvalidUTF8 = errors.New("wordwrap: invalid UTF-8")
+
+const replacementChar = "\ufffd"
+
+// InvalidUTF8Handling is how a SplitBuilder handles invalid UTF-8 in its
+// input, such as the bytes of a bad decode.
+type InvalidUTF8Handling int
+
+const (
+	// InvalidUTF8Replace replaces each invalid byte with U+FFFD, measured
+	// as the replacement character is. This is the default, as with the
+	// conversion of strings to runes in Go.
+	InvalidUTF8Replace InvalidUTF8Handling = iota
+	// InvalidUTF8Skip drops invalid bytes from the output.
+	InvalidUTF8Skip
+	// InvalidUTF8Error stops splitting with ErrInvalidUTF8.
+	InvalidUTF8Error
+	// InvalidUTF8Keep passes invalid bytes through to the output, each
+	// measured as a single byte wide character. Lossless sets it.
+	InvalidUTF8Keep
+)
+
+// InvalidUTF8Policy sets how invalid UTF-8 in the input is handled. Lines
+// passed through by OnlyReflowOverLong are wrapped instead when they hold
+// invalid UTF-8, unless it is kept.
+func InvalidUTF8Policy(h InvalidUTF8Handling) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.invalidUTF8 = h
+		sb.asIs = true
+	}
+}
@@ -0,0 +1,65 @@
+package wordwrap
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestInvalidUTF8Policy(t *testing.T) {
+	tests := []struct {
+		input   string
+		policy  InvalidUTF8Handling
+		output  []string
+		err     error
+		bytelim uint
+	}{
+		{"ab\xffcd ef", InvalidUTF8Replace,
+			[]string{"ab\ufffd", "cd ", "ef"}, nil, 5},
+
+		{"ab\xff\xfecd ef", InvalidUTF8Skip,
+			[]string{"abcd ", "ef"}, nil, 5},
+
+		{"\xffab", InvalidUTF8Skip,
+			[]string{"ab"}, nil, 5},
+
+		{"ab cd \xc3", InvalidUTF8Error,
+			[]string{"ab ", "cd "}, ErrInvalidUTF8, 3},
+
+		{"ab\xffcd ef", InvalidUTF8Keep,
+			[]string{"ab\xffcd", " ef"}, nil, 5},
+
+		{"abc\xed\xa0\x80", InvalidUTF8Replace,
+			[]string{"abc\ufffd\ufffd", "\ufffd"}, nil, 10},
+
+		{"ab\xff", InvalidUTF8Replace,
+			[]string{"ab\ufffd"}, nil, 10},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(InvalidUTF8Policy(test.policy)).SplitString(test.input, test.bytelim)
+		if !reflect.DeepEqual(actual, test.output) || err != test.err {
+			t.Errorf(`SplitString(%#v) = %#v, %v; want %#v, %v`, test.input, actual, err, test.output, test.err)
+		}
+	}
+}
+
+func TestScanWrappedLines_invalidUTF8(t *testing.T) {
+	scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader("しし しし\xff")))
+	scanner.Split(ScanWrappedLines(7, InvalidUTF8Policy(InvalidUTF8Error)))
+
+	actual := []string{}
+	for scanner.Scan() {
+		actual = append(actual, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != ErrInvalidUTF8 {
+		t.Errorf(`Scan error = %v; want %v`, err, ErrInvalidUTF8)
+	}
+
+	if want := []string{"しし "}; !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Scan = %#v; want %#v`, actual, want)
+	}
+}
@@ -0,0 +1,27 @@
+package wordwrap
+
+import "unicode"
+
+// WhitespaceFunc overrides which runes are whitespace, by default those for
+// which unicode.IsSpace reports true. For instance a middot may be treated as
+// a space, or newlines may be excluded so they never offer a break.
+//
+// Whitespace determines the break opportunities and where words begin and end,
+// for the hyphenation of words, the Word separators of SplitWords and whether
+// a word ends exactly at the limit. Hard breaks, KeepTogether phrase matching
+// and Markdown handling are unaffected. A nil fn restores the default.
+func WhitespaceFunc(fn func(r rune) bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.whitespaceFunc = fn
+		sb.asIs = true
+	}
+}
+
+// isSpace reports whether r is whitespace for the SplitBuilder.
+func (sb *SplitBuilder) isSpace(r rune) bool {
+	if sb.whitespaceFunc != nil {
+		return sb.whitespaceFunc(r)
+	}
+
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,41 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWhitespaceFunc(t *testing.T) {
+	middot := func(r rune) bool { return r == ' ' || r == '·' }
+	noNewline := func(r rune) bool { return r == ' ' }
+
+	tests := []struct {
+		input   string
+		fn      func(r rune) bool
+		output  []string
+		bytelim uint
+	}{
+		{"abc·def·ghi", middot,
+			[]string{"abc·", "def·", "ghi"}, 6},
+
+		{"abc·def·ghi", nil,
+			[]string{"abc·d", "ef·gh", "i"}, 6},
+
+		{"ab\ncdef", noNewline,
+			[]string{"ab\ncde", "f"}, 6},
+
+		{"ab\ncdef", nil,
+			[]string{"ab\n", "cdef"}, 6},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(WhitespaceFunc(test.fn)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package wordwrap
+
+// MaxWideCharsPerLine breaks lines after at most k wide characters, those
+// occupying two terminal cells such as East Asian ideographs, for displays
+// rendering wide glyphs poorly. A k of 0 disables the cap.
+//
+// The cap applies on top of the width limit, and a line ends at whichever is
+// reached first. A line reaching k wide characters ends right after the kth,
+// even within a word.
+func MaxWideCharsPerLine(k uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.maxWide = k
+		sb.asIs = k == 0
+	}
+}
+
+// wideChars returns the number of wide characters on the working line.
+func (sp *splitter) wideChars() uint {
+	var n uint
+	for _, c := range sp.chars {
+		if cellWidth(sp.s[c.pos:c.end()]) == 2 {
+			n++
+		}
+	}
+
+	return n
+}
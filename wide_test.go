@@ -0,0 +1,44 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxWideCharsPerLine(t *testing.T) {
+	tests := []struct {
+		input   string
+		k       uint
+		output  []string
+		bytelim uint
+	}{
+		{"クラウンの直接土地", 4,
+			[]string{"クラウン", "の直接土", "地"}, 60},
+
+		{"クラウンの直接土地", 4,
+			[]string{"クラ", "ウン", "の直", "接土", "地"}, 6},
+
+		{"ab 漢字 cd 漢字", 3,
+			[]string{"ab 漢字 cd 漢", "字"}, 60},
+
+		{"ab 漢字 cd 漢字 ef", 2,
+			[]string{"ab 漢字", " cd 漢字", " ef"}, 60},
+
+		{"漢字abc", 2,
+			[]string{"漢字", "abc"}, 60},
+
+		{"クラウンの直接土地", 0,
+			[]string{"クラウンの直接土地"}, 60},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(MaxWideCharsPerLine(test.k)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// Word is a word of a split line along with the whitespace which followed it.
+//
+// Whitespace leading a line is held by a Word with an empty Text.
+type Word struct {
+	Text string
+	Sep  string
+}
+
+// SplitWords splits s as SplitString does, returning each line broken into
+// its words and the separators which followed them.
+//
+// Concatenating the Text and Sep of every word of a line reproduces the line.
+// With CollapseWhitespace the separators are those of the collapsed line, a
+// single space, rather than the runs of whitespace of the input.
+func (sb *SplitBuilder) SplitWords(s string, byteLimit uint) ([][]Word, error) {
+	lines := [][]Word{}
+	err := sb.split(s, byteLimit, func(l line) bool {
+		lines = append(lines, sb.lineWords(l.text))
+		return true
+	})
+
+	return lines, err
+}
+
+func (sb *SplitBuilder) lineWords(line string) []Word {
+	words := []Word{}
+
+	inSep := false
+	start := 0
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		space := sb.isSpace(r)
+
+		switch {
+		case space && !inSep:
+			words = append(words, Word{Text: line[start:i]})
+			start = i
+		case !space && inSep:
+			words[len(words)-1].Sep = line[start:i]
+			start = i
+		}
+
+		inSep = space
+		i += size
+	}
+
+	if inSep {
+		words[len(words)-1].Sep = line[start:]
+	} else if start < len(line) {
+		words = append(words, Word{Text: line[start:]})
+	}
+
+	return words
+}
+
+// JoinWords joins the words of a line. If sep is empty the separators
+// recorded with the words are used, faithfully reproducing the line.
+// Otherwise the words are joined by sep, normalizing the spacing and dropping
+// any leading or trailing whitespace.
+func JoinWords(words []Word, sep string) string {
+	parts := make([]string, 0, len(words)*2)
+	for _, w := range words {
+		if sep == "" {
+			parts = append(parts, w.Text, w.Sep)
+		} else if w.Text != "" {
+			parts = append(parts, w.Text)
+		}
+	}
+
+	if sep == "" {
+		return join(parts, "")
+	}
+
+	return join(parts, sep)
+}
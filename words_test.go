@@ -0,0 +1,79 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder_SplitWords(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  [][]Word
+		bytelim uint
+	}{
+		{"the  quick brown\tfox",
+			[][]Word{
+				{{"the", "  "}, {"quick", " "}},
+				{{"brown", "\t"}, {"fox", ""}},
+			}, 12},
+
+		{"asdasd asd",
+			[][]Word{
+				{{"asda", ""}},
+				{{"sd", " "}},
+				{{"asd", ""}},
+			}, 4},
+
+		{"ab  cd",
+			[][]Word{
+				{{"ab", " "}},
+				{{"", " "}},
+				{{"cd", ""}},
+			}, 3},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder().SplitWords(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitWords(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitWords(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+
+		lines, _ := NewSplitBuilder().SplitString(test.input, test.bytelim)
+		for i, words := range actual {
+			if joined := JoinWords(words, ""); joined != lines[i] {
+				t.Errorf(`JoinWords(%#v, "") = %#v; want %#v`, words, joined, lines[i])
+			}
+		}
+	}
+}
+
+func TestJoinWords(t *testing.T) {
+	words := []Word{{"", " "}, {"the", "  "}, {"quick", "\t"}, {"fox", " "}}
+
+	if actual := JoinWords(words, ""); actual != " the  quick\tfox " {
+		t.Errorf(`JoinWords(%#v, "") = %#v`, words, actual)
+	}
+
+	if actual := JoinWords(words, " "); actual != "the quick fox" {
+		t.Errorf(`JoinWords(%#v, " ") = %#v`, words, actual)
+	}
+}
+
+func TestSplitBuilder_SplitWords_collapseWhitespace(t *testing.T) {
+	actual, err := NewSplitBuilder(CollapseWhitespace(true)).SplitWords("the  quick\t\tbrown fox", 12)
+	if err != nil {
+		t.Fatalf(`SplitWords unexpected error: %s`, err)
+	}
+
+	want := [][]Word{
+		{{"the", " "}, {"quick", " "}},
+		{{"brown", " "}, {"fox", ""}},
+	}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf(`SplitWords = %#v; want %#v`, actual, want)
+	}
+}
@@ -1,69 +1,246 @@
 // Package wordwrap provides methods for breaking a string on a number of bytes
 // in a UTF-8 safe manner such that a rune will never be cut.
+//
+// Separators joining lines, such as the \n of WrapString, are structural: they
+// never count toward the limit, and padding fills the content of a line to the
+// limit exclusive of them.
+//
+// With the default options, wrapping to a wider limit never produces more
+// lines, which layouts searching for a width may rely on. Options which set
+// the limits or breaks of lines by more than what fits may break this:
+// MaxWideCharsPerLine and MarkdownInlineAware are known to, LimitFunc does
+// whenever its limits don't grow with the limit given, and BreakNearest and
+// BreakPriorities are not guaranteed to preserve it.
 package wordwrap
 
 import (
-	"unicode"
+	"errors"
 	"unicode/utf8"
 )
 
-type charPos struct {
-	pos, size int
+// ErrCharacterTooLarge is returned when a single character is larger than the
+// limit of the line it must be placed on, such that it could only be placed by
+// cutting it.
+var ErrCharacterTooLarge = errors.New("wordwrap: character exceeds line limit")
+
+// SplitBuilder splits strings with a configurable set of options.
+//
+// A SplitBuilder is not modified by splitting and may be used concurrently.
+type SplitBuilder struct {
+	firstLineLimit uint
+
+	keepNumberUnitTogether bool
+	markdownInlineAware    bool
+	markdownHardBreaks     bool
+
+	strategies     []Strategy
+	breakLongWords bool
+
+	oversizeHandler OversizeHandlerFunc
+
+	breakNearest uint
+
+	keepTogether []string
+
+	padLastLine bool
+	justify     bool
+	align       Alignment
+	balanced    bool
+
+	widthMode       WidthMode
+	categoryWeights map[string]int
+	categoryRules   []categoryRule
+	zeroAdvance     []rune
+	tabWidth        uint
+	ignoreANSI      bool
+
+	hyphenator Hyphenator
+
+	maxLines uint
+	ellipsis string
+	minLines uint
+
+	emptyInputLine bool
+
+	onlyReflowOverLong bool
+	preserveNewlines   bool
+
+	whitespaceFunc     func(r rune) bool
+	breakFunc          func(prev, next rune) bool
+	coalesceBreakRuns  bool
+	zwspBreaks         bool
+	collapseWhitespace bool
+	honorNBSP          bool
+	softHyphens        bool
+	hyphenBreaks       bool
+	uax14              bool
+
+	boxed             bool
+	boxLeft, boxRight string
+
+	compactThreshold uint
+
+	trimTrailingWhiteSpace bool
+	trimLeadingWhiteSpace  bool
+	trimSetOn              bool
+	trimSet                string
+
+	balanceMode BalanceMode
+
+	emojiWidth uint
+
+	mandatory      bool
+	mandatoryBreak rune
+	hardLimit      uint
+
+	invalidUTF8 InvalidUTF8Handling
+
+	maxWide uint
+
+	transliterated bool
+
+	limitFunc       func(lineIndex int, defaultLimit uint) uint
+	reserveTrailing uint
+
+	nearLimitThreshold uint
+	nearLimit          func(lineIndex int, width uint)
+
+	baseDirection Direction
+	trimVisualEnd bool
+
+	lineSeparatorSet bool
+	lineSeparator    string
+
+	softBreakSet bool
+	softBreak    string
+
+	markAllowance uint
+
+	autoScript bool
+	cjkBreaks  bool
+
+	firstPrefix  string
+	prefix       string
+	linePrefixes []string
+
+	breakPriorities map[rune]int
+
+	errorDropsPartial bool
+
+	// asIs is set when every option applied is known to leave text which
+	// fits on the first line as it is, such that splitting may be skipped.
+	// Each option sets it after its own fields, where it keeps such text as
+	// is, and NewSplitBuilder clears it for the others.
+	asIs bool
 }
 
-// SplitString splits a string at a certain number of bytes without breaking
-// UTF-8 runes and on Unicode space characters when possible.
+// SplitBuilderOption configures a SplitBuilder.
+type SplitBuilderOption func(*SplitBuilder)
+
+// NewSplitBuilder creates a SplitBuilder with the given options applied.
+func NewSplitBuilder(options ...SplitBuilderOption) *SplitBuilder {
+	sb := &SplitBuilder{}
+	asIs := true
+	for _, o := range options {
+		sb.asIs = false
+		o(sb)
+		asIs = asIs && sb.asIs
+	}
+	sb.asIs = asIs
+
+	return sb
+}
+
+// DefaultSplitBuilder is the SplitBuilder used by SplitString and WrapString.
+var DefaultSplitBuilder = NewSplitBuilder()
+
+// FirstLineLimit overrides the byte limit for the first produced line only.
+// The limit given when splitting applies to every line after the first.
 //
-// SplitString will panic if it is forced to split a multibyte rune.
+// This is useful for layouts where the first line is narrower or wider than
+// the rest, for instance a chat bubble beside an avatar. A limit of 0 disables
+// the override.
+func FirstLineLimit(limit uint) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.firstLineLimit = limit
+		sb.asIs = true
+	}
+}
+
+// KeepNumberUnitTogether suppresses the break opportunity between a number
+// and its unit, such as "5 kg", "100 %" or "12 px".
 //
-// For example if the rune `し` (3 bytes) is given, yet we ask it to break on a
-// byte limit of 2, it will panic.
-func SplitString(s string, byteLimit uint) []string {
-	workingLine := ""
-	finishedLines := []string{}
+// The heuristic is a token ending in a digit, a single space (regular or
+// non-breaking), then a unit of 1 to 3 letters or a "%" which ends the input
+// or is followed by a non-letter. Short words following a number, such as
+// "5 in", match as well.
+//
+// This only removes the break opportunity. If no other break fits, the line is
+// still broken before the limit is exceeded.
+func KeepNumberUnitTogether(keep bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.keepNumberUnitTogether = keep
+		sb.asIs = true
+	}
+}
 
-	spacePos := charPos{}
-	lastPos := charPos{}
+// SplitString splits a string at a certain number of bytes without breaking
+// UTF-8 runes and on Unicode space characters when possible.
+//
+// If a character is larger than the limit of the line it falls on the lines
+// produced up to that point are returned along with ErrCharacterTooLarge.
+func (sb *SplitBuilder) SplitString(s string, byteLimit uint) ([]string, error) {
+	if sb.fitsAsIs(s, byteLimit) {
+		return []string{s}, nil
+	}
 
-	for _, r := range s {
-		rl := utf8.RuneLen(r)
+	lines := []string{}
+	err := sb.split(s, byteLimit, func(l line) bool {
+		lines = append(lines, l.text)
+		return true
+	})
 
-		workingLine += string(r)
+	return lines, err
+}
 
-		if unicode.IsSpace(r) {
-			spacePos = charPos{len(workingLine), rl}
-		}
+// fitsAsIs reports whether s is narrower than the limit of the first line and
+// would be split into itself alone, such that splitting can be skipped.
+func (sb *SplitBuilder) fitsAsIs(s string, byteLimit uint) bool {
+	if s == "" || !sb.asIs {
+		return false
+	}
 
-		if len(workingLine) >= int(byteLimit) {
-			if spacePos.size > 0 {
-				finishedLines = append(finishedLines, workingLine[0:spacePos.pos])
-
-				workingLine = workingLine[spacePos.pos:]
-			} else {
-				if len(workingLine) > int(byteLimit) {
-					finishedLines = append(finishedLines, workingLine[0:lastPos.pos])
-					workingLine = workingLine[lastPos.pos:]
-				} else {
-					finishedLines = append(finishedLines, workingLine)
-					workingLine = ""
-				}
-			}
+	if sb.invalidUTF8 != InvalidUTF8Keep && !utf8.ValidString(s) {
+		return false
+	}
 
-			if len(finishedLines[len(finishedLines)-1]) > int(byteLimit) {
-				panic("attempted to cut character")
+	if sb.markdownHardBreaks || sb.onlyReflowOverLong || sb.preserveNewlines {
+		for i := 0; i < len(s); i++ {
+			if s[i] == '\n' {
+				return false
 			}
-
-			spacePos = charPos{}
 		}
-
-		lastPos = charPos{len(workingLine), rl}
 	}
 
-	if workingLine != "" {
-		finishedLines = append(finishedLines, workingLine)
+	return sb.measure(s) < sb.limitFor(0, byteLimit)
+}
+
+// SplitString splits a string at a certain number of bytes without breaking
+// UTF-8 runes and on Unicode space characters when possible. Runes joined by
+// zero-width joiners, as in emoji sequences, are kept together unless they
+// don't fit on a line alone, when they are broken at the joiners.
+//
+// SplitString will panic if it is forced to split a multibyte rune.
+//
+// For example if the rune `し` (3 bytes) is given, yet we ask it to break on a
+// byte limit of 2, it will panic.
+func SplitString(s string, byteLimit uint) []string {
+	lines, err := DefaultSplitBuilder.SplitString(s, byteLimit)
+	if err != nil {
+		panic("attempted to cut character")
 	}
 
-	return finishedLines
+	return lines
 }
 
 // WrapString splits a string as with SplitString and joins together with a \n
@@ -71,6 +248,64 @@ func WrapString(s string, byteLimit uint) string {
 	return join(SplitString(s, byteLimit), "\n")
 }
 
+// Wrap splits s as SplitString does and joins the lines as WrapString would,
+// with the LineSeparator, or the SoftBreakSeparator at breaks inserted by
+// wrapping.
+//
+// If a character is larger than the limit of the line it falls on the lines
+// produced up to that point are joined and returned along with
+// ErrCharacterTooLarge.
+func (sb *SplitBuilder) Wrap(s string, byteLimit uint) (string, error) {
+	if sb.fitsAsIs(s, byteLimit) {
+		return s, nil
+	}
+
+	b := make([]byte, 0, len(s)+len(s)/int(byteLimit+1))
+	sep := ""
+	err := sb.split(s, byteLimit, func(l line) bool {
+		b = append(b, sep...)
+		b = append(b, l.text...)
+		sep = sb.separator(l)
+		return true
+	})
+
+	return string(b), err
+}
+
+// WrapAndSplit splits s as SplitString does, returning both the lines and the
+// lines joined with the LineSeparator, or with the SoftBreakSeparator at
+// breaks inserted by wrapping. The lines are slices of the joined string,
+// which is built in the same pass.
+//
+// If a character is larger than the limit of the line it falls on the lines
+// produced up to that point are returned along with ErrCharacterTooLarge.
+func (sb *SplitBuilder) WrapAndSplit(s string, byteLimit uint) ([]string, string, error) {
+	b := make([]byte, 0, len(s)+len(s)/int(byteLimit+1))
+	bounds := [][2]int{}
+	sep := ""
+	err := sb.split(s, byteLimit, func(l line) bool {
+		b = append(b, sep...)
+		bounds = append(bounds, [2]int{len(b), len(b) + len(l.text)})
+		b = append(b, l.text...)
+		sep = sb.separator(l)
+		return true
+	})
+
+	wrapped := string(b)
+	lines := make([]string, len(bounds))
+	for i, bound := range bounds {
+		lines[i] = wrapped[bound[0]:bound[1]]
+	}
+
+	return lines, wrapped, err
+}
+
+// WrapAndSplit returns the lines of SplitString and the string of WrapString
+// together, a convenience over calling both.
+func WrapAndSplit(s string, byteLimit uint) ([]string, string, error) {
+	return DefaultSplitBuilder.WrapAndSplit(s, byteLimit)
+}
+
 // Copied from https://github.com/golang/go/blob/91911e39/src/strings/strings.go#L351-L370 to avoid a large import tree
 //
 // Copyright 2009 The Go Authors. All rights reserved
@@ -1,7 +1,10 @@
 package wordwrap
 
 import (
+	"bufio"
+	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -52,3 +55,326 @@ func TestSplitString(t *testing.T) {
 		}
 	}
 }
+
+func TestFirstLineLimit(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		first   uint
+		bytelim uint
+	}{
+		{"the quick brown fox jumps over the lazy dog",
+			[]string{"the ", "quick brown fox ", "jumps over the ", "lazy dog"}, 6, 16},
+
+		{"the quick brown fox jumps over the lazy dog",
+			[]string{"the quick brown fox ", "jumps ", "over ", "the ", "lazy ", "dog"}, 20, 6},
+
+		{"short",
+			[]string{"short"}, 10, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(FirstLineLimit(test.first)).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitBuilder_SplitString_characterTooLarge(t *testing.T) {
+	lines, err := NewSplitBuilder().SplitString("ab し", 2)
+	if err != ErrCharacterTooLarge {
+		t.Fatalf(`SplitString error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	want := []string{"ab", " "}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf(`SplitString lines = %#v; want %#v`, lines, want)
+	}
+}
+
+func TestKeepNumberUnitTogether(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  []string
+		bytelim uint
+	}{
+		{"it weighs 5 kg now",
+			[]string{"it weighs ", "5 kg now"}, 12},
+
+		{"a rise of 100 % today",
+			[]string{"a rise of ", "100 % today"}, 14},
+
+		{"width 12\u00a0px here",
+			[]string{"width ", "12\u00a0px here"}, 12},
+
+		{"we ate 5 apples",
+			[]string{"we ate 5 ", "apples"}, 10},
+
+		{"12 px",
+			[]string{"12 p", "x"}, 4},
+	}
+
+	sb := NewSplitBuilder(KeepNumberUnitTogether(true))
+	for _, test := range tests {
+		actual, err := sb.SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestWrapAndSplit(t *testing.T) {
+	inputs := []string{
+		"",
+		"asdasd asd asdasd",
+		"𠜎𠜱00𠝹𠱓𠱸𠲖𠳏𠳕",
+		"Hello, 世界! 👋 ｆｕｌｌｗｉｄｔｈ",
+	}
+
+	for _, input := range inputs {
+		for lim := uint(4); lim < 20; lim++ {
+			lines, wrapped, err := WrapAndSplit(input, lim)
+			if err != nil {
+				t.Fatalf(`WrapAndSplit(%#v, %d) unexpected error: %s`, input, lim, err)
+			}
+
+			if want := SplitString(input, lim); !reflect.DeepEqual(lines, want) {
+				t.Errorf(`WrapAndSplit(%#v, %d) lines = %#v; want %#v`, input, lim, lines, want)
+			}
+
+			if want := WrapString(input, lim); wrapped != want {
+				t.Errorf(`WrapAndSplit(%#v, %d) wrapped = %#v; want %#v`, input, lim, wrapped, want)
+			}
+		}
+	}
+}
+
+func TestSplitBuilder_SplitString_fitsAsIs(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"label", nil, 10},
+		{"ab cd", nil, 5},
+		{"ab cd ", nil, 10},
+		{"ab cd ", []SplitBuilderOption{TrimTrailingWhiteSpace(true)}, 10},
+		{"ab", []SplitBuilderOption{PadLastLine(true)}, 10},
+		{"ab", []SplitBuilderOption{BoxBorders("|", "|")}, 10},
+		{"ab  \ncd", []SplitBuilderOption{MarkdownHardBreaks(true)}, 10},
+		{"ab\ncd", []SplitBuilderOption{OnlyReflowOverLong(true)}, 10},
+		{"abcdef", []SplitBuilderOption{FirstLineLimit(4)}, 10},
+		{"**ab** cd", []SplitBuilderOption{MarkdownInlineAware(true)}, 10},
+	}
+
+	for _, test := range tests {
+		sb := NewSplitBuilder(test.options...)
+
+		var want []string
+		err := sb.split(test.input, test.bytelim, func(l line) bool {
+			want = append(want, l.text)
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual, _ := sb.SplitString(test.input, test.bytelim); !reflect.DeepEqual(actual, want) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, want)
+		}
+	}
+}
+
+func TestNewSplitBuilder_asIs(t *testing.T) {
+	tests := []struct {
+		options []SplitBuilderOption
+		asIs    bool
+	}{
+		{nil, true},
+		{[]SplitBuilderOption{FirstLineLimit(4), PreserveNewlines(true)}, true},
+		{[]SplitBuilderOption{PadLastLine(false), TrimTrailingWhiteSpace(false)}, true},
+		{[]SplitBuilderOption{PadLastLine(true)}, false},
+		{[]SplitBuilderOption{PadLastLine(true), FirstLineLimit(4)}, false},
+		{[]SplitBuilderOption{BoxBorders("|", "|")}, false},
+		{[]SplitBuilderOption{func(sb *SplitBuilder) { sb.padLastLine = true }}, false},
+	}
+
+	for i, test := range tests {
+		if actual := NewSplitBuilder(test.options...).asIs; actual != test.asIs {
+			t.Errorf(`NewSplitBuilder(tests[%d].options...).asIs = %t; want %t`, i, actual, test.asIs)
+		}
+	}
+}
+
+func TestSplitBuilder_SplitString_fitsAsIsOptions(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name, options := range streamingOptions {
+		sb := NewSplitBuilder(options...)
+		for i := 0; i < 300; i++ {
+			input := randomText(r, 6)
+			bytelim := sb.measure(input) + uint(r.Intn(4)) + 1
+
+			var want []string
+			wantErr := sb.split(input, bytelim, func(l line) bool {
+				want = append(want, l.text)
+				return true
+			})
+			if want == nil {
+				want = []string{}
+			}
+
+			actual, err := sb.SplitString(input, bytelim)
+			if err != wantErr || !reflect.DeepEqual(actual, want) {
+				t.Errorf(`%s: SplitString(%#v, %d) = %#v, %v; want %#v, %v`, name, input, bytelim, actual, err, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestSplitString_monotonicLineCount(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pieces := []string{"a", "bb", "cccc", "dddddddd", " ", "  ", "\t", "\n", "し", "é"}
+
+	for i := 0; i < 2000; i++ {
+		s := ""
+		for n := r.Intn(40); n > 0; n-- {
+			s += pieces[r.Intn(len(pieces))]
+		}
+
+		prev := -1
+		for lim := uint(1); lim <= 48; lim++ {
+			lines, err := NewSplitBuilder().SplitString(s, lim)
+			if err != nil {
+				prev = -1
+				continue
+			}
+
+			if prev >= 0 && len(lines) > prev {
+				t.Fatalf(`SplitString(%#v, %d) = %d lines; want at most the %d at %d`, s, lim, len(lines), prev, lim-1)
+			}
+			prev = len(lines)
+		}
+	}
+}
+
+func BenchmarkSplitString_short(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SplitString("a short log line", 80)
+	}
+}
+
+func BenchmarkSplitString_long(b *testing.B) {
+	s := `If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die, and at his death his heir shall be of full age and owe a 'relief', the heir shall have his inheritance on payment of the ancient scale of 'relief'.`
+	for i := 0; i < b.N; i++ {
+		SplitString(s, 60)
+	}
+}
+
+func BenchmarkSplitString_manyLines(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000)
+	for i := 0; i < b.N; i++ {
+		SplitString(s, 40)
+	}
+}
+
+func BenchmarkSplitString_manyLinesWide(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 2000)
+	for i := 0; i < b.N; i++ {
+		SplitString(s, 2000)
+	}
+}
+
+func TestSeparatorNotCounted(t *testing.T) {
+	const input = "aaa bbb ccc dd"
+	const limit = 4
+
+	sb := NewSplitBuilder(PadLastLine(true))
+	want := []string{"aaa ", "bbb ", "ccc ", "dd  "}
+
+	split, _ := sb.SplitString(input, limit)
+	lines, wrapped, _ := sb.WrapAndSplit(input, limit)
+	stats, _, _ := sb.WrapWithStats(input, limit)
+	lazy := Lazy(input, limit, PadLastLine(true)).String()
+
+	keyed, _ := sb.SplitKeyed(input, limit)
+	keyedLines := []string{}
+	for _, l := range keyed {
+		keyedLines = append(keyedLines, l.Text)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	scanner.Split(ScanWrappedLines(limit, PadLastLine(true)))
+	scanned := []string{}
+	for scanner.Scan() {
+		scanned = append(scanned, scanner.Text())
+	}
+
+	for name, actual := range map[string][]string{
+		"SplitString":         split,
+		"WrapAndSplit":        lines,
+		"SplitKeyed":          keyedLines,
+		"Scan":                scanned,
+		"WrapAndSplit joined": strings.Split(wrapped, "\n"),
+		"WrapWithStats":       strings.Split(stats, "\n"),
+		"Lazy":                strings.Split(lazy, "\n"),
+	} {
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf(`%s = %#v; want %#v`, name, actual, want)
+		}
+	}
+}
+
+func TestSplitBuilder_Wrap(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  string
+		bytelim uint
+	}{
+		{"the quick brown fox", nil,
+			"the quick \nbrown fox", 10},
+
+		{"the quick brown fox", []SplitBuilderOption{TrimTrailingWhiteSpace(true)},
+			"the quick\nbrown fox", 10},
+
+		{"ab cd", []SplitBuilderOption{SoftBreakSeparator("\r\n")},
+			"ab \r\ncd", 4},
+
+		{"short", nil,
+			"short", 10},
+
+		{"", nil,
+			"", 10},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).Wrap(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`Wrap(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if actual != test.output {
+			t.Errorf(`Wrap(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
+
+func TestSplitBuilder_Wrap_characterTooLarge(t *testing.T) {
+	wrapped, err := NewSplitBuilder().Wrap("ab し", 2)
+	if err != ErrCharacterTooLarge {
+		t.Fatalf(`Wrap error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if want := "ab\n "; wrapped != want {
+		t.Errorf(`Wrap = %#v; want %#v`, wrapped, want)
+	}
+}
@@ -0,0 +1,31 @@
+package wordwrap
+
+// WrappedText is the result of wrapping a string, shaped to be marshaled
+// directly, for instance as JSON for a web frontend.
+type WrappedText struct {
+	// Lines holds the wrapped lines. It is never nil.
+	Lines []string `json:"lines"`
+	// Truncated is true when lines were dropped from the end of the output
+	// by MaxLines.
+	Truncated bool `json:"truncated"`
+	// Width is the limit the text was wrapped to.
+	Width uint `json:"width"`
+}
+
+// Wrap splits s as a SplitBuilder created with the given options would and
+// returns the lines along with the metadata describing them.
+func Wrap(s string, byteLimit uint, options ...SplitBuilderOption) (WrappedText, error) {
+	lines := []string{}
+	sp := NewSplitBuilder(options...).newSplitter(s, byteLimit, func(l line) bool {
+		lines = append(lines, l.text)
+		return true
+	})
+
+	err := sp.run()
+
+	return WrappedText{
+		Lines:     lines,
+		Truncated: sp.truncated,
+		Width:     byteLimit,
+	}, err
+}
@@ -0,0 +1,36 @@
+package wordwrap
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		input   string
+		output  string
+		bytelim uint
+	}{
+		{"asdasd asd asdasd",
+			`{"lines":["asda","sd ","asd ","asda","sd"],"truncated":false,"width":4}`, 4},
+
+		{"",
+			`{"lines":[],"truncated":false,"width":10}`, 10},
+	}
+
+	for _, test := range tests {
+		wt, err := Wrap(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`Wrap(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		actual, err := json.Marshal(wt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(actual) != test.output {
+			t.Errorf(`json.Marshal(Wrap(%#v)) = %s; want %s`, test.input, actual, test.output)
+		}
+	}
+}
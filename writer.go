@@ -0,0 +1,85 @@
+package wordwrap
+
+import "io"
+
+// Writer is an io.Writer wrapping the text written to it as a SplitBuilder
+// created with the given options would, writing the wrapped lines joined by
+// their separators to an underlying io.Writer as soon as each is complete.
+//
+// Partial words and characters are buffered across calls to Write, so text
+// may be written in pieces of any size, as by io.Copy. The wrapped output is
+// the same as that of Wrap over all the text written before Flush, whatever
+// the options. Balanced and AutoScript look at the whole text to place each
+// line, so with either nothing is written before Flush.
+type Writer struct {
+	w  io.Writer
+	ls *lineScanner
+
+	// buf holds the text written and not yet wrapped from off on
+	buf []byte
+	off int
+	sep string
+	err error
+}
+
+// NewWriter returns a Writer wrapping text to byteLimit and writing it to w.
+// Flush must be called once all text is written to write the last line.
+func NewWriter(w io.Writer, byteLimit uint, options ...SplitBuilderOption) *Writer {
+	return &Writer{
+		w:  w,
+		ls: &lineScanner{sb: NewSplitBuilder(options...), byteLimit: byteLimit},
+	}
+}
+
+// Write buffers p and writes out the lines completed by it.
+//
+// Errors from wrapping, such as ErrCharacterTooLarge, are returned by the
+// Write or Flush reaching them, as are errors from the underlying writer, and
+// by every call after. Once MaxLines lines have been written, Write returns
+// ErrTruncated if any text remains.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	if w.off > 0 {
+		w.buf = append(w.buf[:0], w.buf[w.off:]...)
+		w.off = 0
+	}
+	w.buf = append(w.buf, p...)
+
+	return len(p), w.drain(false)
+}
+
+// Flush writes out the line left in the buffer, which is not written by Write
+// as more text may extend it. Text written after Flush continues on a new
+// line.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.drain(true)
+}
+
+// drain writes out the lines of the buffer known to be complete, or all of
+// them at EOF.
+func (w *Writer) drain(atEOF bool) error {
+	for {
+		advance, l, found, err := w.ls.scan(w.buf[w.off:], atEOF)
+		if err == nil && found {
+			_, err = io.WriteString(w.w, w.sep+l.text)
+			w.sep = w.ls.sb.separator(l)
+		}
+		if err != nil {
+			w.err = err
+			return err
+		}
+
+		if advance == 0 && !found {
+			return nil
+		}
+
+		w.off += advance
+	}
+}
@@ -0,0 +1,146 @@
+package wordwrap
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		bytelim uint
+	}{
+		{"asdasd asd asdasd", nil, 4},
+		{"family 👨‍👩‍👧 and scientist 👩‍🔬 emoji", nil, 20},
+		{"flags 🇺🇸🇯🇵 waving 👋\U0001f3fd hello", nil, 12},
+		{"ééé ệe", []SplitBuilderOption{MeasureBy(MeasureRunes)}, 3},
+		{`If any earl, baron, or other person that holds lands directly of the Crown, for military service, shall die, and at his death his heir shall be of full age and owe a 'relief', the heir shall have his inheritance on payment of the ancient scale of 'relief'.`, nil, 60},
+		{"roses are **red**  \nviolets are _blue_ and so are you", []SplitBuilderOption{MarkdownHardBreaks(true), MarkdownInlineAware(true)}, 14},
+		{"the quick brown fox jumps over the lazy dog", []SplitBuilderOption{LineSeparator("\r\n"), TrimTrailingWhiteSpace(true)}, 10},
+		{"aaa   bbb  ccc\n  ddd", []SplitBuilderOption{TrimLeadingWhiteSpace(true), PreserveNewlines(true)}, 4},
+		{"a   b    c", []SplitBuilderOption{CollapseWhitespace(true)}, 3},
+		{"", nil, 10},
+		{strings.Repeat("lorem 👨\u200d👩\u200d👧 ipsum 🇺🇸🇯🇵 dolor e\u0301\u0302 sit ", 400), nil, 30},
+		{strings.Repeat("\x1b[31mred\x1b[0m and 123 plain ", 500), []SplitBuilderOption{IgnoreANSI(true)}, 20},
+		{strings.Repeat("x", 10000) + " y", nil, 70},
+	}
+
+	for _, test := range tests {
+		want, err := NewSplitBuilder(test.options...).Wrap(test.input, test.bytelim)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, chunk := range []int{1, 2, 3, 7, 5000, len(test.input) + 1} {
+			var b strings.Builder
+			w := NewWriter(&b, test.bytelim, test.options...)
+			for s := test.input; s != ""; {
+				n := chunk
+				if n > len(s) {
+					n = len(s)
+				}
+
+				if _, err := w.Write([]byte(s[:n])); err != nil {
+					t.Fatalf(`Write(%#v) unexpected error: %s`, s[:n], err)
+				}
+				s = s[n:]
+			}
+
+			if err := w.Flush(); err != nil {
+				t.Fatalf(`Flush unexpected error: %s`, err)
+			}
+
+			if b.String() != want {
+				t.Errorf(`Writer(%#v) in chunks of %d = %#v; want %#v`, test.input, chunk, b.String(), want)
+			}
+		}
+	}
+}
+
+func TestWriter_error(t *testing.T) {
+	var b strings.Builder
+	w := NewWriter(&b, 2)
+
+	if _, err := w.Write([]byte("ab しcd")); err != ErrCharacterTooLarge {
+		t.Errorf(`Write error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if err := w.Flush(); err != ErrCharacterTooLarge {
+		t.Errorf(`Flush error = %v; want %v`, err, ErrCharacterTooLarge)
+	}
+
+	if want := "ab\n "; b.String() != want {
+		t.Errorf(`Writer = %#v; want %#v`, b.String(), want)
+	}
+}
+
+func TestWriter_truncated(t *testing.T) {
+	var b strings.Builder
+	w := NewWriter(&b, 10, MaxLines(2))
+
+	_, err := w.Write([]byte("the quick brown fox jumps over the lazy dog"))
+	if err != ErrTruncated {
+		t.Errorf(`Write error = %v; want %v`, err, ErrTruncated)
+	}
+
+	if want := "the quick \nbrown fox "; b.String() != want {
+		t.Errorf(`Writer = %#v; want %#v`, b.String(), want)
+	}
+}
+
+func BenchmarkWriter_largeWrite(b *testing.B) {
+	s := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 5000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		w := NewWriter(ioutil.Discard, 80)
+		w.Write(s)
+		w.Flush()
+	}
+}
+
+func BenchmarkWriter_largeWrite_viaWrapString(b *testing.B) {
+	s := strings.Repeat("the quick brown fox jumps over the lazy dog ", 5000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WrapString(s, 80)
+	}
+}
+
+func TestWriter_options(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name, options := range streamingOptions {
+		sb := NewSplitBuilder(options...)
+		for i := 0; i < 300; i++ {
+			input, bytelim, chunk := randomText(r, 30), uint(r.Intn(14)+1), r.Intn(8)+1
+			want, wantErr := sb.Wrap(input, bytelim)
+
+			var b strings.Builder
+			w := NewWriter(&b, bytelim, options...)
+
+			var err error
+			for s := input; s != "" && err == nil; {
+				n := chunk
+				if n > len(s) {
+					n = len(s)
+				}
+
+				_, err = w.Write([]byte(s[:n]))
+				s = s[n:]
+			}
+			if err == nil {
+				err = w.Flush()
+			}
+
+			if err != wantErr && !(wantErr == nil && err == ErrTruncated) {
+				t.Errorf(`%s: Writer(%#v, %d) error = %v; want %v`, name, input, bytelim, err, wantErr)
+			}
+
+			if b.String() != want {
+				t.Errorf(`%s: Writer(%#v, %d) in chunks of %d = %#v; want %#v`, name, input, bytelim, chunk, b.String(), want)
+			}
+		}
+	}
+}
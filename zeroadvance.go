@@ -0,0 +1,43 @@
+package wordwrap
+
+import "unicode/utf8"
+
+// ZeroAdvanceRunes sets runes which are output but measured as occupying no
+// width, whatever their Unicode category, such as enclosing marks or
+// application placeholder glyphs overlaid on the preceding character.
+//
+// This only affects width accounting. Whether a break may occur before or
+// after the runes is unchanged.
+func ZeroAdvanceRunes(runes ...rune) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.zeroAdvance = append([]rune(nil), runes...)
+		sb.asIs = true
+	}
+}
+
+// stripZeroAdvance returns c without its zero advance runes.
+func (sb *SplitBuilder) stripZeroAdvance(c string) string {
+	out, stripped := c, false
+	for i := 0; i < len(c); {
+		r, size := utf8.DecodeRuneInString(c[i:])
+		switch {
+		case sb.isZeroAdvance(r) && !stripped:
+			out, stripped = c[:i], true
+		case !sb.isZeroAdvance(r) && stripped:
+			out += c[i : i+size]
+		}
+		i += size
+	}
+
+	return out
+}
+
+func (sb *SplitBuilder) isZeroAdvance(r rune) bool {
+	for _, z := range sb.zeroAdvance {
+		if r == z {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZeroAdvanceRunes(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"a\u20dd b\u20dd c\u20dd", []SplitBuilderOption{ZeroAdvanceRunes('\u20dd')},
+			[]string{"a\u20dd b\u20dd ", "c\u20dd"}, 4},
+
+		{"a\u20dd b\u20dd c\u20dd", nil,
+			[]string{"a\u20dd", " ", "b\u20dd", " ", "c\u20dd"}, 4},
+
+		{"a*b*c*d e", []SplitBuilderOption{ZeroAdvanceRunes('*')},
+			[]string{"a*b*c*d", " e"}, 4},
+
+		{"**** ab", []SplitBuilderOption{ZeroAdvanceRunes('*'), MeasureBy(MeasureConservative)},
+			[]string{"**** ", "ab"}, 3},
+
+		{"世\u20dd界 ab", []SplitBuilderOption{ZeroAdvanceRunes('\u20dd'), CategoryWeights(map[string]int{"Wide": 2})},
+			[]string{"世\u20dd界", " ab"}, 4},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}
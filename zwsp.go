@@ -0,0 +1,14 @@
+package wordwrap
+
+const zeroWidthSpace = '\u200b'
+
+// BreakOnZeroWidthSpace makes the zero-width space U+200B a break
+// opportunity, as it is used to hint breaks in text derived from HTML. A
+// zero-width space a line is broken at is dropped from the output, while
+// those within lines are kept, so the hints survive for a later re-wrap.
+func BreakOnZeroWidthSpace(brk bool) SplitBuilderOption {
+	return func(sb *SplitBuilder) {
+		sb.zwspBreaks = brk
+		sb.asIs = !brk
+	}
+}
@@ -0,0 +1,50 @@
+package wordwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakOnZeroWidthSpace(t *testing.T) {
+	tests := []struct {
+		input   string
+		options []SplitBuilderOption
+		output  []string
+		bytelim uint
+	}{
+		{"verylongword\u200bmore", []SplitBuilderOption{BreakOnZeroWidthSpace(true)},
+			[]string{"verylongword", "more"}, 16},
+
+		{"verylongword\u200bmore", nil,
+			[]string{"verylongword\u200bm", "ore"}, 16},
+
+		{"a\u200bb\u200bverylongword", []SplitBuilderOption{BreakOnZeroWidthSpace(true)},
+			[]string{"a\u200bb", "verylongword"}, 14},
+
+		{"ab\u200bcd", []SplitBuilderOption{BreakOnZeroWidthSpace(true)},
+			[]string{"ab\u200bcd"}, 16},
+
+		{"ab\u200b", []SplitBuilderOption{BreakOnZeroWidthSpace(true)},
+			[]string{"ab\u200b"}, 10},
+
+		{"abcdefgh ij\u200b", []SplitBuilderOption{BreakOnZeroWidthSpace(true)},
+			[]string{"abcdefgh ", "ij\u200b"}, 10},
+
+		{"abc\u200b\ndef", []SplitBuilderOption{BreakOnZeroWidthSpace(true), PreserveNewlines(true)},
+			[]string{"abc\u200b", "def"}, 10},
+
+		{"ab cd\u200bef", []SplitBuilderOption{BreakOnZeroWidthSpace(true), Lossless(true)},
+			[]string{"ab ", "cd\u200bef"}, 9},
+	}
+
+	for _, test := range tests {
+		actual, err := NewSplitBuilder(test.options...).SplitString(test.input, test.bytelim)
+		if err != nil {
+			t.Fatalf(`SplitString(%#v) unexpected error: %s`, test.input, err)
+		}
+
+		if !reflect.DeepEqual(actual, test.output) {
+			t.Errorf(`SplitString(%#v) = %#v; want %#v`, test.input, actual, test.output)
+		}
+	}
+}